@@ -0,0 +1,246 @@
+package bunq
+
+import (
+	"github.com/d0x7/go-bunq/model"
+)
+
+// API is the bunq API as used through a Client, by its services. Code that depends on API rather than
+// on *Client can be tested with fakes like the mocks of the bunqmock package, without HTTP.
+type API interface {
+	UserAPI() UserAPI
+	AccountAPI() AccountAPI
+	PaymentAPI() PaymentAPI
+	ScheduledPaymentAPI() ScheduledPaymentAPI
+	CardAPI() CardAPI
+	ContentAPI() ContentAPI
+	RequestResponseAPI() RequestResponseAPI
+	RequestInquiryAPI() RequestInquiryAPI
+	NotificationFilterAPI() NotificationFilterAPI
+	OAuthAPI() OAuthAPI
+	BunqMeAPI() BunqMeAPI
+	EventAPI() EventAPI
+	InvoiceAPI() InvoiceAPI
+	ShareInviteAPI() ShareInviteAPI
+	DirectDebitAPI() DirectDebitAPI
+	InternationalAPI() InternationalAPI
+}
+
+// UserAPI is the API of the user of the session, implemented by Client.UserService.
+type UserAPI interface {
+	GetUser() (model.User, error)
+	GetUserPerson() (*model.ResponseUserPerson, error)
+	UpdateUserPerson(rBody model.RequestUserPersonPut) (*model.ResponseBunqID, error)
+	GetUserCompany() (*model.ResponseUserCompany, error)
+	UpdateUserCompany(rBody model.RequestUserCompanyPut) (*model.ResponseBunqID, error)
+}
+
+// AccountAPI is the API of monetary accounts, implemented by Client.AccountService.
+type AccountAPI interface {
+	GetAllMonetaryAccountBank(params ...model.QueryParam) (*model.ResponseMonetaryAccountBankGet, error)
+	GetMonetaryAccountBank(id int) (*model.ResponseMonetaryAccountBankGet, error)
+	GetAllMonetaryAccountSaving(params ...model.QueryParam) (*model.ResponseMonetaryAccountSavingGet, error)
+	GetMonetaryAccountSaving(id int) (*model.ResponseMonetaryAccountSavingGet, error)
+}
+
+// PaymentAPI is the API of payments and draft payments, implemented by Client.PaymentService.
+type PaymentAPI interface {
+	GetPayment(monetaryAccountID int, paymentID int) (*model.ResponsePaymentGet, error)
+	GetAllPayment(monetaryAccountID int, params ...model.QueryParam) (*model.ResponsePaymentGet, error)
+	GetAllOlderPayment(pagi model.Pagination) (*model.ResponsePaymentGet, error)
+	CreatePayment(monetaryAccountID int, create model.PaymentCreate) (*model.ResponseBunqID, error)
+	CreatePaymentBatch(monetaryAccountID int, create model.PaymentBatchCreate) (*model.ResponseBunqID, error)
+	CreateDraftPayment(monetaryAccountID int, rBody model.RequestCreateDraftPayment) (*model.ResponseBunqID, error)
+	UpdateDraftPayment(id, monetaryAccountID int, rBody model.RequestUpdateDraftPayment) (*model.ResponseBunqID, error)
+	GetDraftPayment(id, monetaryAccountID int) (*model.ResponseDraftPaymentGet, error)
+}
+
+// ScheduledPaymentAPI is the API of scheduled payments, implemented by Client.ScheduledPaymentService.
+type ScheduledPaymentAPI interface {
+	GetAllScheduledPayments(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseScheduledPaymentsGet, error)
+	GetScheduledPayment(monetaryAccountID int, scheduledPaymentID int) (*model.ResponseScheduledPaymentsGet, error)
+}
+
+// CardAPI is the API of cards, implemented by Client.CardService.
+type CardAPI interface {
+	GetAllMasterCardAction(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseMasterCardActionGet, error)
+	GetMasterCardAction(monetaryAccountID int, id int) (*model.ResponseMasterCardActionGet, error)
+}
+
+// ContentAPI is the API of attachments, implemented by Client.ContentService.
+type ContentAPI interface {
+	GetAttachmentPublic(id string) (string, error)
+}
+
+// RequestResponseAPI is the API of requests for money the user received, implemented by Client.RequestResponseService.
+type RequestResponseAPI interface {
+	GetAllRequestResponses(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestResponsesGet, error)
+	GetRequestResponse(monetaryAccountID int, requestResponseID int) (*model.ResponseRequestResponsesGet, error)
+	GetAllOlderRequestResponses(pagi model.Pagination) (*model.ResponseRequestResponsesGet, error)
+	AcceptRequestResponse(monetaryAccountID, requestResponseID int) (*model.ResponseBunqID, error)
+	RejectRequestResponse(monetaryAccountID, requestResponseID int) (*model.ResponseBunqID, error)
+}
+
+// RequestInquiryAPI is the API of requests for money the user made, implemented by Client.RequestInquiryService.
+type RequestInquiryAPI interface {
+	CreateRequestInquiry(monetaryAccountID int, create model.RequestInquiryCreate) (*model.ResponseBunqID, error)
+	GetAllRequestInquiries(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestInquiriesGet, error)
+	GetRequestInquiry(monetaryAccountID, requestInquiryID int) (*model.ResponseRequestInquiriesGet, error)
+}
+
+// NotificationFilterAPI is the API of the notification filters of the user and its monetary accounts,
+// implemented by Client.NotificationFilterService.
+type NotificationFilterAPI interface {
+	GetUserNotificationFilters() (*model.ResponseNotificationFilterURL, error)
+	SetUserNotificationFilters(filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error)
+	ClearUserNotificationFilters() error
+	GetMonetaryAccountNotificationFilters(monetaryAccountID int) (*model.ResponseNotificationFilterURL, error)
+	SetMonetaryAccountNotificationFilters(monetaryAccountID int, filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error)
+	ClearMonetaryAccountNotificationFilters(monetaryAccountID int) error
+}
+
+// OAuthAPI is the API of OAuth clients and their callback URLs, implemented by Client.OAuthService.
+type OAuthAPI interface {
+	CreateOAuthClient() (*model.ResponseBunqID, error)
+	GetAllOAuthClients(params ...model.QueryParam) (*model.ResponseOAuthClients, error)
+	GetOAuthClient(oauthClientID int) (*model.ResponseOAuthClients, error)
+	UpdateOAuthClient(oauthClientID int, rBody model.RequestOAuthClient) (*model.ResponseBunqID, error)
+	CreateOAuthCallbackURL(oauthClientID int, url string) (*model.ResponseBunqID, error)
+	GetAllOAuthCallbackURLs(oauthClientID int) (*model.ResponseOAuthCallbackURLs, error)
+	DeleteOAuthCallbackURL(oauthClientID, callbackURLID int) error
+}
+
+// BunqMeAPI is the API of bunq.me tabs and fundraiser profiles, implemented by Client.BunqMeService.
+type BunqMeAPI interface {
+	CreateBunqMeTab(monetaryAccountID int, create model.BunqMeTabCreate) (*model.ResponseBunqID, error)
+	GetAllBunqMeTabs(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseBunqMeTabsGet, error)
+	GetBunqMeTab(monetaryAccountID, bunqMeTabID int) (*model.ResponseBunqMeTabsGet, error)
+	GetBunqMeTabResultInquiries(monetaryAccountID, bunqMeTabID int) ([]model.BunqMeTabResultInquiry, error)
+	CloseBunqMeTab(monetaryAccountID, bunqMeTabID int) (*model.ResponseBunqID, error)
+	CreateBunqMeFundraiserProfile(create model.BunqMeFundraiserProfileCreate) (*model.ResponseBunqID, error)
+	GetAllBunqMeFundraiserProfiles(params ...model.QueryParam) (*model.ResponseBunqMeFundraiserProfilesGet, error)
+	GetBunqMeFundraiserProfile(profileID int) (*model.ResponseBunqMeFundraiserProfilesGet, error)
+	CloseBunqMeFundraiserProfile(profileID int) (*model.ResponseBunqID, error)
+}
+
+// EventAPI is the API of the event feed of the user, implemented by Client.EventService.
+type EventAPI interface {
+	GetAllEvents(params ...model.QueryParam) (*model.ResponseEventsGet, error)
+	GetAllOlderEvents(pagi model.Pagination) (*model.ResponseEventsGet, error)
+}
+
+// InvoiceAPI is the API of the invoices and billing contracts of the user, implemented by Client.InvoiceService.
+type InvoiceAPI interface {
+	GetAllUserInvoices(params ...model.QueryParam) (*model.ResponseInvoicesGet, error)
+	GetUserInvoice(invoiceID int) (*model.ResponseInvoicesGet, error)
+	GetAllInvoices(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseInvoicesGet, error)
+	GetInvoice(monetaryAccountID, invoiceID int) (*model.ResponseInvoicesGet, error)
+	GetAllOlderInvoices(pagi model.Pagination) (*model.ResponseInvoicesGet, error)
+	GetInvoicePDF(invoiceID int) ([]byte, error)
+	GetAllBillingContracts(params ...model.QueryParam) (*model.ResponseBillingContractsGet, error)
+}
+
+// ShareInviteAPI is the API of the share invites the user sent and received for bunq Connect,
+// implemented by Client.ShareInviteService.
+type ShareInviteAPI interface {
+	CreateShareInvite(monetaryAccountID int, create model.ShareInviteCreate) (*model.ResponseBunqID, error)
+	GetAllShareInvites(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseShareInvitesGet, error)
+	GetShareInvite(monetaryAccountID, shareInviteID int) (*model.ResponseShareInvitesGet, error)
+	UpdateShareInvite(monetaryAccountID, shareInviteID int, update model.ShareInviteUpdate) (*model.ResponseBunqID, error)
+	RevokeShareInvite(monetaryAccountID, shareInviteID int) (*model.ResponseBunqID, error)
+	GetAllShareInviteResponses(params ...model.QueryParam) (*model.ResponseShareInviteResponsesGet, error)
+	GetShareInviteResponse(shareInviteResponseID int) (*model.ResponseShareInviteResponsesGet, error)
+	AcceptShareInviteResponse(shareInviteResponseID int) (*model.ResponseBunqID, error)
+	RejectShareInviteResponse(shareInviteResponseID int) (*model.ResponseBunqID, error)
+}
+
+// DirectDebitAPI is the API of the SEPA direct debits of the user: their mandates, the whitelisted creditors and
+// accepting and rejecting them by rules, implemented by Client.DirectDebitService.
+type DirectDebitAPI interface {
+	GetAllDirectDebitMandates(monetaryAccountID int) ([]model.DirectDebitMandate, error)
+	ProcessDirectDebits(monetaryAccountID int, rules model.DirectDebitRules) ([]model.DirectDebitDecision, error)
+	CreateSddWhitelist(create model.SddWhitelistCreate) (*model.ResponseBunqID, error)
+	GetAllSddWhitelists(params ...model.QueryParam) (*model.ResponseSddWhitelistsGet, error)
+	GetSddWhitelist(whitelistID int) (*model.ResponseSddWhitelistsGet, error)
+	DeleteSddWhitelist(whitelistID int) error
+}
+
+// InternationalAPI is the API of currency conversions and international transfers through Wise,
+// implemented by Client.InternationalService.
+type InternationalAPI interface {
+	CreateCurrencyConversionQuote(monetaryAccountID int, create model.CurrencyConversionQuoteCreate) (*model.ResponseBunqID, error)
+	GetCurrencyConversionQuote(monetaryAccountID, quoteID int) (*model.ResponseCurrencyConversionQuotesGet, error)
+	AcceptCurrencyConversionQuote(monetaryAccountID, quoteID int) (*model.ResponseBunqID, error)
+	CreateTransferwiseQuote(create model.TransferwiseQuoteCreate) (*model.ResponseBunqID, error)
+	GetTransferwiseQuote(quoteID int) (*model.ResponseTransferwiseQuotesGet, error)
+	CreateTransferwiseRecipient(quoteID int, create model.TransferwiseRecipientCreate) (*model.ResponseBunqID, error)
+	CreateTransferwiseTransfer(quoteID int, create model.TransferwiseTransferCreate) (*model.ResponseBunqID, error)
+	GetTransferwiseTransfer(quoteID, transferID int) (*model.ResponseTransferwiseTransfersGet, error)
+}
+
+var (
+	_ API                   = (*Client)(nil)
+	_ UserAPI               = (*userService)(nil)
+	_ AccountAPI            = (*accountService)(nil)
+	_ PaymentAPI            = (*paymentService)(nil)
+	_ ScheduledPaymentAPI   = (*scheduledPaymentService)(nil)
+	_ CardAPI               = (*cardService)(nil)
+	_ ContentAPI            = (*contentService)(nil)
+	_ RequestResponseAPI    = (*requestResponseService)(nil)
+	_ RequestInquiryAPI     = (*requestInquiryService)(nil)
+	_ NotificationFilterAPI = (*notificationFilterService)(nil)
+	_ OAuthAPI              = (*oauthService)(nil)
+	_ BunqMeAPI             = (*bunqMeService)(nil)
+	_ EventAPI              = (*eventService)(nil)
+	_ InvoiceAPI            = (*invoiceService)(nil)
+	_ ShareInviteAPI        = (*shareInviteService)(nil)
+	_ DirectDebitAPI        = (*directDebitService)(nil)
+	_ InternationalAPI      = (*internationalService)(nil)
+)
+
+// UserAPI returns the UserService as UserAPI.
+func (c *Client) UserAPI() UserAPI { return c.UserService }
+
+// AccountAPI returns the AccountService as AccountAPI.
+func (c *Client) AccountAPI() AccountAPI { return c.AccountService }
+
+// PaymentAPI returns the PaymentService as PaymentAPI.
+func (c *Client) PaymentAPI() PaymentAPI { return c.PaymentService }
+
+// ScheduledPaymentAPI returns the ScheduledPaymentService as ScheduledPaymentAPI.
+func (c *Client) ScheduledPaymentAPI() ScheduledPaymentAPI { return c.ScheduledPaymentService }
+
+// CardAPI returns the CardService as CardAPI.
+func (c *Client) CardAPI() CardAPI { return c.CardService }
+
+// ContentAPI returns the ContentService as ContentAPI.
+func (c *Client) ContentAPI() ContentAPI { return c.ContentService }
+
+// RequestResponseAPI returns the RequestResponseService as RequestResponseAPI.
+func (c *Client) RequestResponseAPI() RequestResponseAPI { return c.RequestResponseService }
+
+// RequestInquiryAPI returns the RequestInquiryService as RequestInquiryAPI.
+func (c *Client) RequestInquiryAPI() RequestInquiryAPI { return c.RequestInquiryService }
+
+// NotificationFilterAPI returns the NotificationFilterService as NotificationFilterAPI.
+func (c *Client) NotificationFilterAPI() NotificationFilterAPI { return c.NotificationFilterService }
+
+// OAuthAPI returns the OAuthService as OAuthAPI.
+func (c *Client) OAuthAPI() OAuthAPI { return c.OAuthService }
+
+// BunqMeAPI returns the BunqMeService as BunqMeAPI.
+func (c *Client) BunqMeAPI() BunqMeAPI { return c.BunqMeService }
+
+// EventAPI returns the EventService as EventAPI.
+func (c *Client) EventAPI() EventAPI { return c.EventService }
+
+// InvoiceAPI returns the InvoiceService as InvoiceAPI.
+func (c *Client) InvoiceAPI() InvoiceAPI { return c.InvoiceService }
+
+// ShareInviteAPI returns the ShareInviteService as ShareInviteAPI.
+func (c *Client) ShareInviteAPI() ShareInviteAPI { return c.ShareInviteService }
+
+// DirectDebitAPI returns the DirectDebitService as DirectDebitAPI.
+func (c *Client) DirectDebitAPI() DirectDebitAPI { return c.DirectDebitService }
+
+// InternationalAPI returns the InternationalService as InternationalAPI.
+func (c *Client) InternationalAPI() InternationalAPI { return c.InternationalService }
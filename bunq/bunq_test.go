@@ -16,6 +16,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"testing"
 )
@@ -43,6 +45,17 @@ func createBunqFakeHandler(t *testing.T) http.HandlerFunc {
 			default:
 				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
 			}
+		case "user/6084":
+			sendResponseWithSignature(t, w, http.StatusOK, getUserPersonGetResponse(t))
+		case "user-company/6084":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getUserCompanyGetResponse(t))
+			case http.MethodPut:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
 		case "user/6084/monetary-account/9512/draft-payment":
 			switch r.Method {
 			case http.MethodPost:
@@ -70,6 +83,167 @@ func createBunqFakeHandler(t *testing.T) http.HandlerFunc {
 			sendResponseWithSignature(t, w, http.StatusOK, getPaymentGet(t))
 		case "user/6084/monetary-account/9601/schedule-payment":
 			sendResponseWithSignature(t, w, http.StatusOK, getScheduledPaymentGet(t))
+		case "user/6084/notification-filter-url", "user/6084/monetary-account/9512/notification-filter-url":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getNotificationFilterURLResponse(t))
+			case http.MethodPost:
+				var body map[string]json.RawMessage
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil || string(body["notification_filters"])[0] != '[' {
+					t.Errorf("notification filters must be sent as a list, got %q", body["notification_filters"])
+				}
+				sendResponseWithSignature(t, w, http.StatusOK, getNotificationFilterURLResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/oauth-client", "user/6084/oauth-client/7", "user/6084/oauth-client/7/callback-url":
+			switch r.Method {
+			case http.MethodGet:
+				if strings.HasSuffix(r.URL.Path, "callback-url") {
+					sendResponseWithSignature(t, w, http.StatusOK, getOAuthCallbackURLResponse(t))
+				} else {
+					sendResponseWithSignature(t, w, http.StatusOK, getOAuthClientResponse(t))
+				}
+			case http.MethodPost, http.MethodPut:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/oauth-client/7/callback-url/12":
+			if r.Method != http.MethodDelete {
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+			sendResponseWithSignature(t, w, http.StatusOK, &model.ResponseBunqID{Response: []model.WrappedBunqID{}})
+		case "user/6084/monetary-account/9512/request-inquiry", "user/6084/monetary-account/9512/request-inquiry/1234":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getRequestInquiryResponse(t))
+			case http.MethodPost:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/monetary-account/9512/bunqme-tab", "user/6084/monetary-account/9512/bunqme-tab/73",
+			"user/6084/bunqme-fundraiser-profile", "user/6084/bunqme-fundraiser-profile/12":
+			switch r.Method {
+			case http.MethodGet:
+				if strings.Contains(r.URL.Path, "fundraiser") {
+					sendResponseWithSignature(t, w, http.StatusOK, getBunqMeFundraiserProfileResponse(t))
+				} else {
+					sendResponseWithSignature(t, w, http.StatusOK, getBunqMeTabResponse(t))
+				}
+			case http.MethodPost:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			case http.MethodPut:
+				var body model.RequestBunqMeStatus
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Status == "" {
+					t.Errorf("closing a bunq.me tab or fundraiser profile must set its status, got %+v", body)
+				}
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/event":
+			sendResponseWithSignature(t, w, http.StatusOK, getEventResponse(t))
+		case "user/6084/invoice", "user/6084/invoice/8012", "user/6084/monetary-account/9512/invoice", "user/6084/monetary-account/9512/invoice/8012":
+			sendResponseWithSignature(t, w, http.StatusOK, getInvoiceResponse(t))
+		case "user/6084/monetary-account/9512/share-invite-monetary-account-inquiry",
+			"user/6084/monetary-account/9512/share-invite-monetary-account-inquiry/41",
+			"user/6084/monetary-account/9512/share-invite-monetary-account-inquiry/42":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getShareInviteResponse(t, r.URL.Path))
+			case http.MethodPost:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			case http.MethodPut:
+				var body model.ShareInviteUpdate
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Error(err)
+				}
+				// A pending invite is revoked, an accepted one cancelled.
+				if strings.HasSuffix(r.URL.Path, "/41") && body.Status != model.ShareInviteStatusRevoked ||
+					strings.HasSuffix(r.URL.Path, "/42") && body.Status != "" && body.Status != model.ShareInviteStatusCancelled {
+					t.Errorf("unexpected status %q for %s", body.Status, r.URL.Path)
+				}
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/share-invite-monetary-account-response", "user/6084/share-invite-monetary-account-response/88":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getShareInviteResponseResponse(t))
+			case http.MethodPut:
+				var body model.RequestShareInviteStatus
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Status == "" {
+					t.Errorf("answering a share invite must set its status, got %+v", body)
+				}
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/monetary-account/9513/request-response":
+			sendResponseWithSignature(t, w, http.StatusOK, getDirectDebitResponse(t))
+		case "user/6084/monetary-account/9513/request-response/504", "user/6084/monetary-account/9513/request-response/505",
+			"user/6084/monetary-account/9513/request-response/506":
+			var body model.RequestRequestResponseStatus
+			if r.Method != http.MethodPut || json.NewDecoder(r.Body).Decode(&body) != nil {
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+			// The Eneco direct debit of 45.00 matches the rules of the tests, the others don't.
+			if strings.HasSuffix(r.URL.Path, "/506") != (body.Status == model.RequestResponseStatusAccepted) {
+				t.Errorf("unexpected status %q for %s", body.Status, r.URL.Path)
+			}
+			sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+		case "user/6084/whitelist-sdd", "user/6084/whitelist-sdd/31":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getSddWhitelistResponse(t))
+			case http.MethodPost:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			case http.MethodDelete:
+				sendResponseWithSignature(t, w, http.StatusOK, &model.ResponseBunqID{Response: []model.WrappedBunqID{}})
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/monetary-account/9512/currency-conversion-quote",
+			"user/6084/monetary-account/9512/currency-conversion-quote/61",
+			"user/6084/monetary-account/9512/currency-conversion-quote/62":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getCurrencyConversionQuoteResponse(t, r.URL.Path))
+			case http.MethodPost:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			case http.MethodPut:
+				if strings.HasSuffix(r.URL.Path, "/62") {
+					t.Errorf("an expired quote must not be accepted: %s", r.URL.Path)
+				}
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/transferwise-quote", "user/6084/transferwise-quote/71", "user/6084/transferwise-quote/72":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getTransferwiseQuoteResponse(t, r.URL.Path))
+			case http.MethodPost:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/transferwise-quote/71/transferwise-recipient", "user/6084/transferwise-quote/71/transferwise-transfer":
+			if r.Method != http.MethodPost {
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+			sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+		case "user/6084/transferwise-quote/72/transferwise-transfer":
+			t.Errorf("a transfer must not be created for an expired quote: %s", r.URL.Path)
+		case "user/6084/transferwise-quote/71/transferwise-transfer/81":
+			sendResponseWithSignature(t, w, http.StatusOK, getTransferwiseTransferResponse(t))
+		case "user/6084/invoice/8012/pdf-content":
+			sendRawResponseWithSignature(w, http.StatusOK, []byte(invoicePDF))
+		case "user/6084/billing-contract-subscription":
+			sendResponseWithSignature(t, w, http.StatusOK, getBillingContractResponse(t))
 		case "user/6084/monetary-account/9999/request-response":
 			sendResponseWithSignature(t, w, http.StatusOK, getRequestResponseGet(t))
 		case "attachment-public/f9a1a89a-fdc1-4de5-89d5-e477cccd22c4/content":
@@ -133,6 +307,13 @@ func getUserPersonGetResponse(t *testing.T) *model.ResponseUserPerson {
 	return res.(*model.ResponseUserPerson)
 }
 
+func getUserCompanyGetResponse(t *testing.T) *model.ResponseUserCompany {
+	var obj model.ResponseUserCompany
+	res := createResponseStruct(t, formatFilePathByName("user_company_get_response"), &obj)
+
+	return res.(*model.ResponseUserCompany)
+}
+
 func getMonetaryAccountBankGet(t *testing.T) *model.ResponseMonetaryAccountBankGet {
 	var obj model.ResponseMonetaryAccountBankGet
 	res := createResponseStruct(t, formatFilePathByName("monetary_account_bank_listing_response"), &obj)
@@ -189,6 +370,13 @@ func getRequestResponseGet(t *testing.T) *model.ResponseRequestResponsesGet {
 	return res.(*model.ResponseRequestResponsesGet)
 }
 
+func getNotificationFilterURLResponse(t *testing.T) *model.ResponseNotificationFilterURL {
+	var obj model.ResponseNotificationFilterURL
+	res := createResponseStruct(t, formatFilePathByName("notification_filter_url_response"), &obj)
+
+	return res.(*model.ResponseNotificationFilterURL)
+}
+
 func getErrorResponse(t *testing.T) *model.ResponseError {
 	var obj model.ResponseError
 	res := createResponseStruct(t, formatFilePathByName("error_response"), &obj)
@@ -231,6 +419,17 @@ func sendResponseWithSignature(t *testing.T, w http.ResponseWriter, resCode int,
 	}
 }
 
+func sendRawResponseWithSignature(w http.ResponseWriter, resCode int, body []byte) {
+	h := sha256.New()
+	_, _ = h.Write(body)
+
+	signature, _ := rsa.SignPKCS1v15(rand.Reader, loadPrivateKey(), crypto.SHA256, h.Sum(nil))
+	w.Header().Set("X-Bunq-Server-Signature", base64.StdEncoding.EncodeToString(signature))
+	w.WriteHeader(resCode)
+
+	_, _ = w.Write(body)
+}
+
 var loadPrivateKeyOnce sync.Once
 var privateKey *rsa.PrivateKey
 
@@ -244,3 +443,144 @@ func loadPrivateKey() *rsa.PrivateKey {
 
 	return privateKey
 }
+
+func getOAuthClientResponse(t *testing.T) *model.ResponseOAuthClients {
+	var obj model.ResponseOAuthClients
+	res := createResponseStruct(t, formatFilePathByName("oauth_client_response"), &obj)
+
+	return res.(*model.ResponseOAuthClients)
+}
+
+func getOAuthCallbackURLResponse(t *testing.T) *model.ResponseOAuthCallbackURLs {
+	var obj model.ResponseOAuthCallbackURLs
+	res := createResponseStruct(t, formatFilePathByName("oauth_callback_url_response"), &obj)
+
+	return res.(*model.ResponseOAuthCallbackURLs)
+}
+
+func getRequestInquiryResponse(t *testing.T) *model.ResponseRequestInquiriesGet {
+	var obj model.ResponseRequestInquiriesGet
+	res := createResponseStruct(t, formatFilePathByName("request_inquiry_response"), &obj)
+
+	return res.(*model.ResponseRequestInquiriesGet)
+}
+
+func getBunqMeTabResponse(t *testing.T) *model.ResponseBunqMeTabsGet {
+	var obj model.ResponseBunqMeTabsGet
+	res := createResponseStruct(t, formatFilePathByName("bunqme_tab_response"), &obj)
+
+	return res.(*model.ResponseBunqMeTabsGet)
+}
+
+func getBunqMeFundraiserProfileResponse(t *testing.T) *model.ResponseBunqMeFundraiserProfilesGet {
+	var obj model.ResponseBunqMeFundraiserProfilesGet
+	res := createResponseStruct(t, formatFilePathByName("bunqme_fundraiser_profile_response"), &obj)
+
+	return res.(*model.ResponseBunqMeFundraiserProfilesGet)
+}
+
+func getInvoiceResponse(t *testing.T) *model.ResponseInvoicesGet {
+	var obj model.ResponseInvoicesGet
+	res := createResponseStruct(t, formatFilePathByName("invoice_response"), &obj)
+
+	return res.(*model.ResponseInvoicesGet)
+}
+
+func getBillingContractResponse(t *testing.T) *model.ResponseBillingContractsGet {
+	var obj model.ResponseBillingContractsGet
+	res := createResponseStruct(t, formatFilePathByName("billing_contract_response"), &obj)
+
+	return res.(*model.ResponseBillingContractsGet)
+}
+
+// getShareInviteResponse returns the share invites, or only the one with the ID at the end of path, if any.
+func getShareInviteResponse(t *testing.T, path string) *model.ResponseShareInvitesGet {
+	var obj model.ResponseShareInvitesGet
+	res := createResponseStruct(t, formatFilePathByName("share_invite_response"), &obj).(*model.ResponseShareInvitesGet)
+
+	id, err := strconv.Atoi(path[strings.LastIndex(path, "/")+1:])
+	if err != nil {
+		return res
+	}
+
+	filtered := res.Response[:0]
+	for _, r := range res.Response {
+		if r.ShareInvite.ID == id {
+			filtered = append(filtered, r)
+		}
+	}
+	res.Response = filtered
+
+	return res
+}
+
+func getShareInviteResponseResponse(t *testing.T) *model.ResponseShareInviteResponsesGet {
+	var obj model.ResponseShareInviteResponsesGet
+	res := createResponseStruct(t, formatFilePathByName("share_invite_response_response"), &obj)
+
+	return res.(*model.ResponseShareInviteResponsesGet)
+}
+
+func getDirectDebitResponse(t *testing.T) *model.ResponseRequestResponsesGet {
+	var obj model.ResponseRequestResponsesGet
+	res := createResponseStruct(t, formatFilePathByName("direct_debit_response"), &obj)
+
+	return res.(*model.ResponseRequestResponsesGet)
+}
+
+func getSddWhitelistResponse(t *testing.T) *model.ResponseSddWhitelistsGet {
+	var obj model.ResponseSddWhitelistsGet
+	res := createResponseStruct(t, formatFilePathByName("sdd_whitelist_response"), &obj)
+
+	return res.(*model.ResponseSddWhitelistsGet)
+}
+
+// getCurrencyConversionQuoteResponse returns the quotes, or only the one with the ID at the end of path, if any.
+func getCurrencyConversionQuoteResponse(t *testing.T, path string) *model.ResponseCurrencyConversionQuotesGet {
+	var obj model.ResponseCurrencyConversionQuotesGet
+	res := createResponseStruct(t, formatFilePathByName("currency_conversion_quote_response"), &obj).(*model.ResponseCurrencyConversionQuotesGet)
+
+	if id, err := strconv.Atoi(path[strings.LastIndex(path, "/")+1:]); err == nil {
+		filtered := res.Response[:0]
+		for _, r := range res.Response {
+			if r.CurrencyConversionQuote.ID == id {
+				filtered = append(filtered, r)
+			}
+		}
+		res.Response = filtered
+	}
+
+	return res
+}
+
+// getTransferwiseQuoteResponse returns the quotes, or only the one with the ID at the end of path, if any.
+func getTransferwiseQuoteResponse(t *testing.T, path string) *model.ResponseTransferwiseQuotesGet {
+	var obj model.ResponseTransferwiseQuotesGet
+	res := createResponseStruct(t, formatFilePathByName("transferwise_quote_response"), &obj).(*model.ResponseTransferwiseQuotesGet)
+
+	if id, err := strconv.Atoi(path[strings.LastIndex(path, "/")+1:]); err == nil {
+		filtered := res.Response[:0]
+		for _, r := range res.Response {
+			if r.TransferwiseQuote.ID == id {
+				filtered = append(filtered, r)
+			}
+		}
+		res.Response = filtered
+	}
+
+	return res
+}
+
+func getTransferwiseTransferResponse(t *testing.T) *model.ResponseTransferwiseTransfersGet {
+	var obj model.ResponseTransferwiseTransfersGet
+	res := createResponseStruct(t, formatFilePathByName("transferwise_transfer_response"), &obj)
+
+	return res.(*model.ResponseTransferwiseTransfersGet)
+}
+
+func getEventResponse(t *testing.T) *model.ResponseEventsGet {
+	var obj model.ResponseEventsGet
+	res := createResponseStruct(t, formatFilePathByName("event_response"), &obj)
+
+	return res.(*model.ResponseEventsGet)
+}
@@ -0,0 +1,175 @@
+package bunq
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// BunqMeTabStatusCancelled is the status of a closed bunq.me tab.
+	BunqMeTabStatusCancelled = "CANCELLED"
+	// BunqMeFundraiserProfileStatusDeactivated is the status of a closed bunq.me fundraiser profile.
+	BunqMeFundraiserProfileStatusDeactivated = "DEACTIVATED"
+)
+
+type bunqMeService service
+
+// CreateBunqMeTab creates a bunq.me tab, a payment link for a fixed amount to the given account.
+// Its share URL is in the tab returned by GetBunqMeTab.
+// https://doc.bunq.com/#/bunqme-tab/CREATE_BunqMeTab_for_User_MonetaryAccount
+func (b *bunqMeService) CreateBunqMeTab(monetaryAccountID int, create model.BunqMeTabCreate) (*model.ResponseBunqID, error) {
+	if err := create.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
+	userID, err := b.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return b.client.doCURequest(b.client.formatRequestURL(fmt.Sprintf(endpointBunqMeTab, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// GetAllBunqMeTabs returns the bunq.me tabs of the given account.
+// https://doc.bunq.com/#/bunqme-tab/List_all_BunqMeTab_for_User_MonetaryAccount
+func (b *bunqMeService) GetAllBunqMeTabs(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseBunqMeTabsGet, error) {
+	userID, err := b.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.client.preformRequest(http.MethodGet, b.client.formatRequestURL(fmt.Sprintf(endpointBunqMeTab, userID, monetaryAccountID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseBunqMeTabsGet
+
+	return &resStruct, b.client.parseResponse(res, &resStruct)
+}
+
+// GetBunqMeTab returns a bunq.me tab of the given account, with its share URL and the payments received through it.
+// https://doc.bunq.com/#/bunqme-tab/READ_BunqMeTab_for_User_MonetaryAccount
+func (b *bunqMeService) GetBunqMeTab(monetaryAccountID, bunqMeTabID int) (*model.ResponseBunqMeTabsGet, error) {
+	userID, err := b.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.client.preformRequest(http.MethodGet, b.client.formatRequestURL(fmt.Sprintf(endpointBunqMeTabWithID, userID, monetaryAccountID, bunqMeTabID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseBunqMeTabsGet
+
+	return &resStruct, b.client.parseResponse(res, &resStruct)
+}
+
+// GetBunqMeTabResultInquiries returns the payments received through a bunq.me tab of the given account.
+func (b *bunqMeService) GetBunqMeTabResultInquiries(monetaryAccountID, bunqMeTabID int) ([]model.BunqMeTabResultInquiry, error) {
+	res, err := b.GetBunqMeTab(monetaryAccountID, bunqMeTabID)
+	if err != nil {
+		return nil, err
+	}
+
+	tabs := res.BunqMeTabs()
+	if len(tabs) == 0 {
+		return nil, errors.Errorf("bunq: bunq.me tab %d not found", bunqMeTabID)
+	}
+
+	return tabs[0].ResultInquiries, nil
+}
+
+// CloseBunqMeTab cancels a bunq.me tab of the given account, so it can't be paid anymore.
+// https://doc.bunq.com/#/bunqme-tab/UPDATE_BunqMeTab_for_User_MonetaryAccount
+func (b *bunqMeService) CloseBunqMeTab(monetaryAccountID, bunqMeTabID int) (*model.ResponseBunqID, error) {
+	userID, err := b.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(model.RequestBunqMeStatus{Status: BunqMeTabStatusCancelled})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return b.client.doCURequest(b.client.formatRequestURL(fmt.Sprintf(endpointBunqMeTabWithID, userID, monetaryAccountID, bunqMeTabID)), bodyRaw, http.MethodPut)
+}
+
+// CreateBunqMeFundraiserProfile creates a bunq.me fundraiser profile, a payment link for any amount to the given account.
+// https://doc.bunq.com/#/bunqme-fundraiser-profile/CREATE_BunqMeFundraiserProfile_for_User
+func (b *bunqMeService) CreateBunqMeFundraiserProfile(create model.BunqMeFundraiserProfileCreate) (*model.ResponseBunqID, error) {
+	userID, err := b.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return b.client.doCURequest(b.client.formatRequestURL(fmt.Sprintf(endpointBunqMeFundraiserProfile, userID)), bodyRaw, http.MethodPost)
+}
+
+// GetAllBunqMeFundraiserProfiles returns the bunq.me fundraiser profiles of the current auth user.
+// https://doc.bunq.com/#/bunqme-fundraiser-profile/List_all_BunqMeFundraiserProfile_for_User
+func (b *bunqMeService) GetAllBunqMeFundraiserProfiles(params ...model.QueryParam) (*model.ResponseBunqMeFundraiserProfilesGet, error) {
+	userID, err := b.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.client.preformRequest(http.MethodGet, b.client.formatRequestURL(fmt.Sprintf(endpointBunqMeFundraiserProfile, userID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseBunqMeFundraiserProfilesGet
+
+	return &resStruct, b.client.parseResponse(res, &resStruct)
+}
+
+// GetBunqMeFundraiserProfile returns a bunq.me fundraiser profile with its share URL.
+// https://doc.bunq.com/#/bunqme-fundraiser-profile/READ_BunqMeFundraiserProfile_for_User
+func (b *bunqMeService) GetBunqMeFundraiserProfile(profileID int) (*model.ResponseBunqMeFundraiserProfilesGet, error) {
+	userID, err := b.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.client.preformRequest(http.MethodGet, b.client.formatRequestURL(fmt.Sprintf(endpointBunqMeFundraiserProfileWithID, userID, profileID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseBunqMeFundraiserProfilesGet
+
+	return &resStruct, b.client.parseResponse(res, &resStruct)
+}
+
+// CloseBunqMeFundraiserProfile deactivates a bunq.me fundraiser profile, so it can't be paid anymore.
+// https://doc.bunq.com/#/bunqme-fundraiser-profile/UPDATE_BunqMeFundraiserProfile_for_User
+func (b *bunqMeService) CloseBunqMeFundraiserProfile(profileID int) (*model.ResponseBunqID, error) {
+	userID, err := b.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(model.RequestBunqMeStatus{Status: BunqMeFundraiserProfileStatusDeactivated})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return b.client.doCURequest(b.client.formatRequestURL(fmt.Sprintf(endpointBunqMeFundraiserProfileWithID, userID, profileID)), bodyRaw, http.MethodPut)
+}
@@ -0,0 +1,78 @@
+package bunq
+
+import (
+	"github.com/d0x7/go-bunq/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBunqMeTabs(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.BunqMeService.CreateBunqMeTab(9512, model.BunqMeTabCreate{Entry: model.BunqMeTabEntryCreate{
+		AmountInquired: model.MustParseAmount("125.00 EUR"),
+		Description:    "Invoice 2024-017",
+		RedirectURL:    "https://example.com/paid",
+	}})
+	assert.NoError(t, err)
+	assert.NotZero(t, res.ID())
+
+	_, err = c.BunqMeService.CreateBunqMeTab(9512, model.BunqMeTabCreate{Entry: model.BunqMeTabEntryCreate{
+		AmountInquired: model.MustParseAmount("0.00 EUR"),
+	}})
+	assert.Error(t, err)
+
+	all, err := c.BunqMeService.GetAllBunqMeTabs(9512)
+	assert.NoError(t, err)
+	require.Len(t, all.BunqMeTabs(), 1)
+
+	tab, err := c.BunqMeService.GetBunqMeTab(9512, 73)
+	assert.NoError(t, err)
+	require.Len(t, tab.BunqMeTabs(), 1)
+	assert.Equal(t, "https://bunq.me/t/5a6c2b1e-8f2d-4e0b-9c1a-3f4e5d6c7b8a", tab.BunqMeTabs()[0].ShareURL)
+	assert.Equal(t, "125.00 EUR", tab.BunqMeTabs()[0].Entry.AmountInquired.String())
+
+	results, err := c.BunqMeService.GetBunqMeTabResultInquiries(9512, 73)
+	assert.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, 73, results[0].BunqMeTabID)
+	assert.Equal(t, "Grace Hopper", results[0].Payment.CounterpartyAlias.DisplayName)
+
+	_, err = c.BunqMeService.CloseBunqMeTab(9512, 73)
+	assert.NoError(t, err)
+}
+
+func TestBunqMeFundraiserProfiles(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.BunqMeService.CreateBunqMeFundraiserProfile(model.BunqMeFundraiserProfileCreate{MonetaryAccountID: 9512, Description: "Pay your invoices here"})
+	assert.NoError(t, err)
+	assert.NotZero(t, res.ID())
+
+	all, err := c.BunqMeService.GetAllBunqMeFundraiserProfiles()
+	assert.NoError(t, err)
+	require.Len(t, all.BunqMeFundraiserProfiles(), 1)
+
+	profile, err := c.BunqMeService.GetBunqMeFundraiserProfile(12)
+	assert.NoError(t, err)
+	require.Len(t, profile.BunqMeFundraiserProfiles(), 1)
+	assert.Equal(t, "https://bunq.me/Barrett", profile.BunqMeFundraiserProfiles()[0].ShareURL)
+	assert.Equal(t, 9512, profile.BunqMeFundraiserProfiles()[0].MonetaryAccountID)
+
+	_, err = c.BunqMeService.CloseBunqMeFundraiserProfile(12)
+	assert.NoError(t, err)
+}
@@ -79,17 +79,23 @@ type Client struct {
 
 	Err error
 
-	requestQueue             chan queueEntry
-	requestRateLimitMapMutex sync.RWMutex
-	requestRateLimitMap      map[string]time.Time
-	rateLimitPolicy          backoff
+	requestQueue    chan queueEntry
+	rateLimiter     *rateLimiter
+	rateLimitPolicy backoff
+
+	// onRequest is called before and after every request. The Manager uses it to track when the client was last used.
+	onRequest func()
+
+	// cancel stops the workers of the client, closeOnce makes sure the session is deleted only once.
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+	closeErr  error
 
 	privateKey      *rsa.PrivateKey
 	serverPublicKey *rsa.PublicKey
 
-	isUserPerson  bool
-	isUserCompany bool
-	isUserAPIkey  bool
+	// user is the user the session belongs to.
+	user model.User
 
 	// initOnce makes sure that init is called only once per instance. This will help that no new
 	// new device keeps being registered etc.
@@ -101,17 +107,26 @@ type Client struct {
 	installationContext  *model.Installation
 	sessionServerContext *model.SessionServer
 
-	common                  service
-	installation            *installationService
-	deviceServer            *deviceServerService
-	sessionServer           *sessionServerService
-	UserService             *userService
-	AccountService          *accountService
-	PaymentService          *paymentService
-	ScheduledPaymentService *scheduledPaymentService
-	CardService             *cardService
-	ContentService          *contentService
-	RequestResponseService  *requestResponseService
+	common                    service
+	installation              *installationService
+	deviceServer              *deviceServerService
+	sessionServer             *sessionServerService
+	UserService               *userService
+	AccountService            *accountService
+	PaymentService            *paymentService
+	ScheduledPaymentService   *scheduledPaymentService
+	CardService               *cardService
+	ContentService            *contentService
+	RequestResponseService    *requestResponseService
+	RequestInquiryService     *requestInquiryService
+	NotificationFilterService *notificationFilterService
+	OAuthService              *oauthService
+	BunqMeService             *bunqMeService
+	EventService              *eventService
+	InvoiceService            *invoiceService
+	ShareInviteService        *shareInviteService
+	DirectDebitService        *directDebitService
+	InternationalService      *internationalService
 }
 
 // NewClientFromContext create a new bunq client from a saved client context.
@@ -139,7 +154,7 @@ func NewClientFromContext(ctx context.Context, clientCtx *model.ClientContext) (
 	c.sessionServerContext = clientCtx.SessionServerContext
 	c.token = &c.sessionServerContext.Token.Token
 
-	c.updateUserFlag()
+	c.updateUser()
 
 	return c, nil
 }
@@ -147,7 +162,7 @@ func NewClientFromContext(ctx context.Context, clientCtx *model.ClientContext) (
 // NewClient create a new bunq client to use.
 func NewClient(ctx context.Context, baseURL string, key *rsa.PrivateKey, apikey, description string, permittedIps []string) *Client {
 	c := Client{}
-	c.ctx = ctx
+	c.ctx, c.cancel = context.WithCancel(ctx)
 	c.Client = http.DefaultClient
 	c.baseURL = baseURL
 	c.description = description
@@ -164,7 +179,7 @@ func NewClient(ctx context.Context, baseURL string, key *rsa.PrivateKey, apikey,
 // NewEmptyClient creates a new empty client.
 func NewEmptyClient(ctx context.Context) *Client {
 	c := Client{}
-	c.ctx = ctx
+	c.ctx, c.cancel = context.WithCancel(ctx)
 	c.Client = http.DefaultClient
 	c.baseURL = DetermineBaseURL()
 
@@ -175,7 +190,7 @@ func NewEmptyClient(ctx context.Context) *Client {
 
 func (c *Client) registerServices() {
 	c.requestQueue = make(chan queueEntry, 9)
-	c.requestRateLimitMap = make(map[string]time.Time)
+	c.rateLimiter = newRateLimiter()
 	c.rateLimitPolicy = NewDefaultBackoff()
 
 	c.common.client = c
@@ -190,6 +205,15 @@ func (c *Client) registerServices() {
 	c.CardService = (*cardService)(&c.common)
 	c.ContentService = (*contentService)(&c.common)
 	c.RequestResponseService = (*requestResponseService)(&c.common)
+	c.RequestInquiryService = (*requestInquiryService)(&c.common)
+	c.NotificationFilterService = (*notificationFilterService)(&c.common)
+	c.OAuthService = (*oauthService)(&c.common)
+	c.BunqMeService = (*bunqMeService)(&c.common)
+	c.EventService = (*eventService)(&c.common)
+	c.InvoiceService = (*invoiceService)(&c.common)
+	c.ShareInviteService = (*shareInviteService)(&c.common)
+	c.DirectDebitService = (*directDebitService)(&c.common)
+	c.InternationalService = (*internationalService)(&c.common)
 
 	c.spawnRequestHandlerWorker()
 }
@@ -214,24 +238,16 @@ func (c *Client) spawnRequestHandlerWorker() {
 		for {
 			select {
 			case <-c.ctx.Done():
-				close(c.requestQueue)
 				return
-			default:
-				entry := <-c.requestQueue
-
-				lastRequest := c.getLastExecutionTimeForRequest(entry.req)
-				diff := time.Now().UTC().Sub(lastRequest)
-
-				if diff.Seconds() < 1.0 {
+			case entry := <-c.requestQueue:
+				if wait := c.rateLimiter.reserve(entry.req.URL.Path); wait > 0 {
 					if c.Debug {
-						log.Printf("bunq: waiting %f seconds before sending the http request.", 1.0-diff.Seconds())
+						log.Printf("bunq: waiting %f seconds before sending the http request.", wait.Seconds())
 					}
 
-					time.Sleep(time.Duration((1.0 - diff.Seconds()) * float64(time.Second)))
+					time.Sleep(wait)
 				}
 
-				go c.registerRequestInRateLimitMap(entry.req)
-
 				if c.Debug {
 					dump, _ := httputil.DumpRequest(entry.req, true)
 					log.Printf("\n%s\n", dump)
@@ -240,7 +256,7 @@ func (c *Client) spawnRequestHandlerWorker() {
 				res, err := c.Do(entry.req)
 
 				// if the request failed due to rate limiting, we will retry it with a backoff policy
-				if res.StatusCode == http.StatusTooManyRequests && !c.DisableBackoff {
+				if err == nil && res.StatusCode == http.StatusTooManyRequests && !c.DisableBackoff {
 					for {
 						nextLimit := c.rateLimitPolicy.NextLimit()
 
@@ -258,7 +274,7 @@ func (c *Client) spawnRequestHandlerWorker() {
 						time.Sleep(nextLimit)
 
 						res, err = c.Do(entry.req)
-						if res.StatusCode != http.StatusTooManyRequests {
+						if err != nil || res.StatusCode != http.StatusTooManyRequests {
 							if c.Debug {
 								fmt.Printf("bunq: request succeeded after %d retries\n", c.rateLimitPolicy.Try())
 							}
@@ -283,25 +299,12 @@ func (c *Client) spawnRequestHandlerWorker() {
 	}()
 }
 
-func (c *Client) getLastExecutionTimeForRequest(r *http.Request) time.Time {
-	c.requestRateLimitMapMutex.RLock()
-	defer c.requestRateLimitMapMutex.RUnlock()
-
-	if t, ok := c.requestRateLimitMap[r.URL.Path]; ok {
-		return t
+func (c *Client) do(r *http.Request) (*http.Response, error) {
+	if c.onRequest != nil {
+		c.onRequest()
+		defer c.onRequest()
 	}
 
-	return time.Now().UTC().Add(time.Duration(-2) * time.Second)
-}
-
-func (c *Client) registerRequestInRateLimitMap(r *http.Request) {
-	c.requestRateLimitMapMutex.Lock()
-	defer c.requestRateLimitMapMutex.Unlock()
-
-	c.requestRateLimitMap[r.URL.Path] = time.Now().UTC()
-}
-
-func (c *Client) do(r *http.Request) (*http.Response, error) {
 	err := c.setAllNeededHeader(r)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: could not set all required headers")
@@ -310,14 +313,20 @@ func (c *Client) do(r *http.Request) (*http.Response, error) {
 	resChan := make(chan *http.Response, 1)
 	errChan := make(chan error, 1)
 
-	c.requestQueue <- queueEntry{
-		req:     r,
-		resChan: resChan,
-		errChan: errChan,
+	select {
+	case c.requestQueue <- queueEntry{req: r, resChan: resChan, errChan: errChan}:
+	case <-c.ctx.Done():
+		return nil, errors.Wrap(c.ctx.Err(), "bunq: client is closed")
 	}
 
-	res, err := <-resChan, <-errChan
-	if err != nil {
+	var res *http.Response
+	select {
+	case res = <-resChan:
+	case <-c.ctx.Done():
+		return nil, errors.Wrap(c.ctx.Err(), "bunq: client is closed")
+	}
+
+	if err := <-errChan; err != nil {
 		return nil, err
 	}
 
@@ -496,29 +505,29 @@ func (c *Client) preformNewInstallation(errChan chan error) {
 	}
 }
 
+// User returns the current auth user, a model.UserPerson, model.UserCompany or model.UserAPIKey.
+// It returns nil before the client was initialised.
+func (c *Client) User() model.User {
+	return c.user
+}
+
 // IsUserPerson returns true if the current auth user is of type UserPerson
 func (c *Client) IsUserPerson() bool {
-	return c.isUserPerson
+	return c.user != nil && c.user.UserType() == model.UserTypePerson
 }
 
 // IsUserCompany returns true if the current auth user is of type UserCompany
 func (c *Client) IsUserCompany() bool {
-	return c.isUserCompany
+	return c.user != nil && c.user.UserType() == model.UserTypeCompany
 }
 
 // IsUserAPIKey returns true if the current auth user is of type UserApiKey
 func (c *Client) IsUserAPIKey() bool {
-	return c.isUserAPIkey
+	return c.user != nil && c.user.UserType() == model.UserTypeAPIKey
 }
 
-func (c *Client) updateUserFlag() {
-	if c.sessionServerContext.UserPerson.ID != 0 {
-		c.isUserPerson = true
-	} else if c.sessionServerContext.UserCompany.ID != 0 {
-		c.isUserCompany = true
-	} else if c.sessionServerContext.UserAPIKey.ID != 0 {
-		c.isUserAPIkey = true
-	}
+func (c *Client) updateUser() {
+	c.user = c.sessionServerContext.User()
 }
 
 // spawnSessionHandlingWorker makes sure that the user session is always valid. This is to ensure that no 403
@@ -532,15 +541,15 @@ func (c *Client) spawnSessionHandlingWorker() {
 		for {
 			select {
 			case <-c.ctx.Done():
-				err := c.sessionServer.delete()
-				if err != nil {
-					c.Err = errors.Wrap(err, "bunq: session handler: could not delete session")
+				if err := c.deleteSession(); err != nil {
+					c.Err = errors.Wrap(err, "bunq: session handler")
 				}
 				return
 			default:
 				expSec, err := c.getSessionExpInSec()
 				if err != nil {
 					c.Err = errors.Wrap(err, "bunq: could not get exp time")
+					c.sleep(time.Minute)
 					continue
 				}
 
@@ -557,7 +566,9 @@ func (c *Client) spawnSessionHandlingWorker() {
 						log.Printf("bunq: session worker will sleep for %f seconds until it renews the session.", timeToSleep.Seconds())
 					}
 
-					time.Sleep(timeToSleep)
+					if !c.sleep(timeToSleep) {
+						continue
+					}
 				}
 
 				c.setInstallationToken()
@@ -570,17 +581,55 @@ func (c *Client) spawnSessionHandlingWorker() {
 	}()
 }
 
-func (c *Client) getSessionExpInSec() (int64, error) {
-	if c.IsUserPerson() {
-		return c.sessionServerContext.UserPerson.SessionTimeout, nil
-	} else if c.IsUserCompany() {
-		if c.sessionServerContext.UserCompany.SessionTimeout == 0 {
-			return 60, nil
+// sleep waits for d, and reports false if the client was closed in the meantime.
+func (c *Client) sleep(d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return true
+	case <-c.ctx.Done():
+		return false
+	}
+}
+
+// Close deletes the session of the client and stops its workers.
+// The client can't be used anymore afterwards, but its context may be loaded again.
+func (c *Client) Close() error {
+	err := c.deleteSession()
+	c.cancel()
+
+	return err
+}
+
+// deleteSession deletes the session of the client, if it has one, once.
+func (c *Client) deleteSession() error {
+	c.closeOnce.Do(func() {
+		if c.sessionServerContext == nil {
+			return
+		}
+
+		if err := c.sessionServer.delete(); err != nil {
+			c.closeErr = errors.Wrap(err, "bunq: could not delete session")
 		}
-		return c.sessionServerContext.UserCompany.SessionTimeout, nil
+	})
+
+	return c.closeErr
+}
+
+func (c *Client) getSessionExpInSec() (int64, error) {
+	if c.user == nil {
+		return 0, fmt.Errorf("bunq: could not get user expirty time")
+	}
+
+	// Companies and API keys granted by them may not have a session timeout set.
+	timeout := model.SessionTimeout(c.user)
+	if timeout == 0 && !c.IsUserPerson() {
+		return 60, nil
 	}
 
-	return 0, fmt.Errorf("bunq: could not get user expirty time")
+	return timeout, nil
 }
 
 func (c *Client) setInstallationToken() {
@@ -592,12 +641,8 @@ func (c *Client) setInstallationToken() {
 
 // GetUserID returns the user id of the current auth user.
 func (c *Client) GetUserID() (int, error) {
-	if c.isUserPerson {
-		return c.sessionServerContext.UserPerson.ID, nil
-	} else if c.isUserCompany {
-		return c.sessionServerContext.UserCompany.ID, nil
-	} else if c.isUserAPIkey {
-		return c.sessionServerContext.UserAPIKey.ID, nil
+	if c.user != nil {
+		return c.user.UserID(), nil
 	}
 
 	return 0, fmt.Errorf("bunq: could not determine user id")
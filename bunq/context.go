@@ -38,20 +38,23 @@ func SaveContext(client *Client, contextFile string) error {
 		return errors.Wrap(err, "exporting 	client context")
 	}
 
+	return writeContextFile(contextFile, &clientContext)
+}
+
+func writeContextFile(contextFile string, clientContext *model.ClientContext) error {
 	indent, err := json.MarshalIndent(clientContext, "", "    ")
 	if err != nil {
 		return errors.Wrap(err, "marshaling client context")
 	}
 
-	if os.WriteFile(contextFile, indent, 0600) != nil {
+	if err := os.WriteFile(contextFile, indent, 0600); err != nil {
 		return errors.Wrap(err, "writing client context")
 	}
 
 	return nil
 }
 
-// LoadContext loads a previously created API context from the specified file and initializes a new client from it.
-func LoadContext(ctx context.Context, file string) (*Client, error) {
+func readContextFile(file string) (*model.ClientContext, error) {
 	var clientContext model.ClientContext
 
 	readFile, err := os.ReadFile(file)
@@ -64,12 +67,22 @@ func LoadContext(ctx context.Context, file string) (*Client, error) {
 		return nil, errors.Wrap(err, "unmarshaling client context")
 	}
 
-	client, err := NewClientFromContext(ctx, &clientContext)
+	return &clientContext, nil
+}
+
+// LoadContext loads a previously created API context from the specified file and initializes a new client from it.
+func LoadContext(ctx context.Context, file string) (*Client, error) {
+	clientContext, err := readContextFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := NewClientFromContext(ctx, clientContext)
 	if err != nil {
 		return nil, errors.Wrap(err, "creating client from context")
 	}
 
-	if client.Init() != nil {
+	if err := client.Init(); err != nil {
 		return nil, errors.Wrap(err, "initializing bunq client")
 	}
 
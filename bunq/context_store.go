@@ -0,0 +1,61 @@
+package bunq
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownTenant is returned by a ContextStore for tenants it holds no API context for.
+var ErrUnknownTenant = errors.New("bunq: unknown tenant")
+
+// ContextStore stores the API contexts of the tenants of a Manager.
+type ContextStore interface {
+	// Load returns the API context of the tenant, or ErrUnknownTenant if there is none.
+	Load(ctx context.Context, tenantID string) (*model.ClientContext, error)
+	// Save stores the API context of the tenant, replacing an existing one.
+	Save(ctx context.Context, tenantID string, clientCtx *model.ClientContext) error
+}
+
+// FileContextStore is a ContextStore keeping the API context of each tenant in a file
+// in Dir, in the same format as SaveContext. The files are named after the tenant ids.
+type FileContextStore struct {
+	Dir string
+}
+
+// Load reads the API context of the tenant.
+func (s FileContextStore) Load(_ context.Context, tenantID string) (*model.ClientContext, error) {
+	file, err := s.file(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCtx, err := readContextFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrapf(ErrUnknownTenant, "tenant %q", tenantID)
+	}
+
+	return clientCtx, err
+}
+
+// Save writes the API context of the tenant.
+func (s FileContextStore) Save(_ context.Context, tenantID string, clientCtx *model.ClientContext) error {
+	file, err := s.file(tenantID)
+	if err != nil {
+		return err
+	}
+
+	return writeContextFile(file, clientCtx)
+}
+
+func (s FileContextStore) file(tenantID string) (string, error) {
+	if tenantID == "" || tenantID == "." || tenantID == ".." || strings.ContainsAny(tenantID, `/\`) {
+		return "", errors.Errorf("bunq: invalid tenant id %q", tenantID)
+	}
+
+	return filepath.Join(s.Dir, tenantID+".json"), nil
+}
@@ -0,0 +1,151 @@
+package bunq
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/d0x7/go-bunq/pagination"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type directDebitService service
+
+// GetAllDirectDebitMandates returns the SEPA direct debit mandates creditors collected money from the given account with,
+// derived from all its direct debits, see model.DirectDebitMandates. Use Active to leave out those whose last
+// direct debit was rejected.
+func (d *directDebitService) GetAllDirectDebitMandates(monetaryAccountID int) ([]model.DirectDebitMandate, error) {
+	responses, err := d.allRequestResponses(monetaryAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.DirectDebitMandates(responses), nil
+}
+
+// ProcessDirectDebits accepts and rejects the pending direct debits of the given account as the rules decide,
+// and returns the decisions. If updating a direct debit fails, the decisions made until then are returned with the error.
+func (d *directDebitService) ProcessDirectDebits(monetaryAccountID int, rules model.DirectDebitRules) ([]model.DirectDebitDecision, error) {
+	responses, err := d.allRequestResponses(monetaryAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var decisions []model.DirectDebitDecision
+	for _, r := range responses {
+		if !r.IsDirectDebit() || r.Status != model.RequestResponseStatusPending {
+			continue
+		}
+
+		decision := rules.Decide(r)
+
+		switch decision.Status {
+		case model.RequestResponseStatusAccepted:
+			_, err = d.client.RequestResponseService.AcceptRequestResponse(monetaryAccountID, r.ID)
+		case model.RequestResponseStatusRejected:
+			_, err = d.client.RequestResponseService.RejectRequestResponse(monetaryAccountID, r.ID)
+		}
+		if err != nil {
+			return decisions, errors.Wrapf(err, "bunq: could not update direct debit %d", r.ID)
+		}
+
+		decisions = append(decisions, decision)
+	}
+
+	return decisions, nil
+}
+
+func (d *directDebitService) allRequestResponses(monetaryAccountID int) ([]model.RequestResponse, error) {
+	res, err := d.client.RequestResponseService.GetAllRequestResponses(monetaryAccountID, pagination.Count(200))
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []model.RequestResponse
+	for res != nil {
+		for _, r := range res.Response {
+			responses = append(responses, r.RequestResponse)
+		}
+
+		res, err = d.client.RequestResponseService.GetAllOlderRequestResponses(res.Pagination)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return responses, nil
+}
+
+// CreateSddWhitelist whitelists the creditor of a direct debit the user received, so bunq accepts its
+// direct debits from the paying account up to the maximum amount per month.
+// https://doc.bunq.com/#/whitelist-sdd/CREATE_WhitelistSdd_for_User
+func (d *directDebitService) CreateSddWhitelist(create model.SddWhitelistCreate) (*model.ResponseBunqID, error) {
+	if err := create.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
+	userID, err := d.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return d.client.doCURequest(d.client.formatRequestURL(fmt.Sprintf(endpointSddWhitelist, userID)), bodyRaw, http.MethodPost)
+}
+
+// GetAllSddWhitelists returns the whitelisted creditors of the current auth user.
+// https://doc.bunq.com/#/whitelist-sdd/List_all_WhitelistSdd_for_User
+func (d *directDebitService) GetAllSddWhitelists(params ...model.QueryParam) (*model.ResponseSddWhitelistsGet, error) {
+	userID, err := d.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.client.preformRequest(http.MethodGet, d.client.formatRequestURL(fmt.Sprintf(endpointSddWhitelist, userID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseSddWhitelistsGet
+
+	return &resStruct, d.client.parseResponse(res, &resStruct)
+}
+
+// GetSddWhitelist returns a whitelisted creditor of the current auth user.
+// https://doc.bunq.com/#/whitelist-sdd/READ_WhitelistSdd_for_User
+func (d *directDebitService) GetSddWhitelist(whitelistID int) (*model.ResponseSddWhitelistsGet, error) {
+	userID, err := d.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := d.client.preformRequest(http.MethodGet, d.client.formatRequestURL(fmt.Sprintf(endpointSddWhitelistWithID, userID, whitelistID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseSddWhitelistsGet
+
+	return &resStruct, d.client.parseResponse(res, &resStruct)
+}
+
+// DeleteSddWhitelist removes a creditor from the whitelist, so the user is asked again to accept its direct debits.
+// https://doc.bunq.com/#/whitelist-sdd/DELETE_WhitelistSdd_for_User
+func (d *directDebitService) DeleteSddWhitelist(whitelistID int) error {
+	userID, err := d.client.GetUserID()
+	if err != nil {
+		return err
+	}
+
+	res, err := d.client.preformRequest(http.MethodDelete, d.client.formatRequestURL(fmt.Sprintf(endpointSddWhitelistWithID, userID, whitelistID)), nil)
+	if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
@@ -0,0 +1,101 @@
+package bunq
+
+import (
+	"github.com/d0x7/go-bunq/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllDirectDebitMandates(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	mandates, err := c.DirectDebitService.GetAllDirectDebitMandates(9513)
+	assert.NoError(t, err)
+	require.Len(t, mandates, 4)
+
+	assert.Equal(t, "ENECO-2291", mandates[0].MandateID)
+	assert.Equal(t, "Eneco", mandates[0].Counterparty.DisplayName)
+	assert.Equal(t, 2, mandates[0].Collections)
+	assert.Equal(t, 2024, mandates[0].FirstCollection.Year())
+	assert.Equal(t, "45.00 EUR", mandates[0].LastAmount.String())
+	assert.True(t, mandates[0].Active())
+
+	assert.Equal(t, "SAAS-1", mandates[3].MandateID)
+	assert.Equal(t, model.RequestResponseTypeDirectDebitB2B, mandates[3].Type)
+	assert.False(t, mandates[3].Active())
+}
+
+func TestProcessDirectDebits(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	maxAmount := model.MustParseAmount("100.00 EUR")
+	rules := model.DirectDebitRules{
+		MaxAmount:          &maxAmount,
+		AllowedCreditorIDs: []string{"NL12ZZZ300000001234"},
+		BlockedCreditorIDs: []string{"NL98ZZZ999999990000"},
+	}
+
+	// The fake server checks the status every direct debit is updated to.
+	decisions, err := c.DirectDebitService.ProcessDirectDebits(9513, rules)
+	assert.NoError(t, err)
+	require.Len(t, decisions, 3)
+
+	assert.Equal(t, 506, decisions[0].RequestResponse.ID)
+	assert.Equal(t, model.RequestResponseStatusAccepted, decisions[0].Status)
+	assert.Equal(t, model.RequestResponseStatusRejected, decisions[1].Status)
+	assert.Equal(t, "creditor NL98ZZZ999999990000 is blocked", decisions[1].Reason)
+	assert.Equal(t, "", decisions[2].Status)
+	assert.Equal(t, "amount 250.00 EUR exceeds 100.00 EUR", decisions[2].Reason)
+
+	rules.RejectUnmatched = true
+	decisions, err = c.DirectDebitService.ProcessDirectDebits(9513, rules)
+	assert.NoError(t, err)
+	require.Len(t, decisions, 3)
+	assert.Equal(t, model.RequestResponseStatusRejected, decisions[2].Status)
+}
+
+func TestSddWhitelist(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.DirectDebitService.CreateSddWhitelist(model.SddWhitelistCreate{
+		MonetaryAccountPayingID: 9513,
+		RequestID:               506,
+		MaximumAmountPerMonth:   model.MustParseAmount("150.00 EUR"),
+	})
+	assert.NoError(t, err)
+	assert.NotZero(t, res.ID())
+
+	_, err = c.DirectDebitService.CreateSddWhitelist(model.SddWhitelistCreate{MonetaryAccountPayingID: 9513, RequestID: 506})
+	assert.Error(t, err)
+
+	whitelists, err := c.DirectDebitService.GetAllSddWhitelists()
+	assert.NoError(t, err)
+	require.Len(t, whitelists.SddWhitelists(), 1)
+	assert.Equal(t, "NL12ZZZ300000001234", whitelists.SddWhitelists()[0].CreditSchemeID)
+	assert.Equal(t, "150.00 EUR", whitelists.SddWhitelists()[0].MaximumAmountPerMonth.String())
+
+	_, err = c.DirectDebitService.GetSddWhitelist(31)
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.DirectDebitService.DeleteSddWhitelist(31))
+}
@@ -7,7 +7,9 @@ const (
 
 	endpointSessionServerCreate string = "session-server"
 
-	endpointUserPersonGet string = "user-person/%d"
+	endpointUserGet        string = "user/%d"
+	endpointUserPersonGet  string = "user-person/%d"
+	endpointUserCompanyGet string = "user-company/%d"
 
 	endpointPaymentBatchCreate string = "user/%d/monetary-account/%d/payment-batch"
 
@@ -31,6 +33,47 @@ const (
 	endpointMasterCardActionGet       string = "user/%d/monetary-account/%d/mastercard-action"
 	endpointMasterCardActionGetWithID string = "user/%d/monetary-account/%d/mastercard-action/%d"
 
+	endpointNotificationFilterURLUser            string = "user/%d/notification-filter-url"
+	endpointNotificationFilterURLMonetaryAccount string = "user/%d/monetary-account/%d/notification-filter-url"
+
 	endpointRequestResponsesGet       string = "user/%d/monetary-account/%d/request-response"
 	endpointRequestResponsesGetWithID string = "user/%d/monetary-account/%d/request-response/%d"
+
+	endpointSddWhitelist       string = "user/%d/whitelist-sdd"
+	endpointSddWhitelistWithID string = "user/%d/whitelist-sdd/%d"
+
+	endpointRequestInquiry       string = "user/%d/monetary-account/%d/request-inquiry"
+	endpointRequestInquiryWithID string = "user/%d/monetary-account/%d/request-inquiry/%d"
+
+	endpointBunqMeTab                     string = "user/%d/monetary-account/%d/bunqme-tab"
+	endpointBunqMeTabWithID               string = "user/%d/monetary-account/%d/bunqme-tab/%d"
+	endpointBunqMeFundraiserProfile       string = "user/%d/bunqme-fundraiser-profile"
+	endpointBunqMeFundraiserProfileWithID string = "user/%d/bunqme-fundraiser-profile/%d"
+
+	endpointEvent string = "user/%d/event"
+
+	endpointCurrencyConversionQuote       string = "user/%d/monetary-account/%d/currency-conversion-quote"
+	endpointCurrencyConversionQuoteWithID string = "user/%d/monetary-account/%d/currency-conversion-quote/%d"
+	endpointTransferwiseQuote             string = "user/%d/transferwise-quote"
+	endpointTransferwiseQuoteWithID       string = "user/%d/transferwise-quote/%d"
+	endpointTransferwiseRecipient         string = "user/%d/transferwise-quote/%d/transferwise-recipient"
+	endpointTransferwiseTransfer          string = "user/%d/transferwise-quote/%d/transferwise-transfer"
+	endpointTransferwiseTransferWithID    string = "user/%d/transferwise-quote/%d/transferwise-transfer/%d"
+
+	endpointInvoiceByUser               string = "user/%d/invoice"
+	endpointInvoiceByUserWithID         string = "user/%d/invoice/%d"
+	endpointInvoicePDFContent           string = "user/%d/invoice/%d/pdf-content"
+	endpointInvoice                     string = "user/%d/monetary-account/%d/invoice"
+	endpointInvoiceWithID               string = "user/%d/monetary-account/%d/invoice/%d"
+	endpointBillingContractSubscription string = "user/%d/billing-contract-subscription"
+
+	endpointShareInvite               string = "user/%d/monetary-account/%d/share-invite-monetary-account-inquiry"
+	endpointShareInviteWithID         string = "user/%d/monetary-account/%d/share-invite-monetary-account-inquiry/%d"
+	endpointShareInviteResponse       string = "user/%d/share-invite-monetary-account-response"
+	endpointShareInviteResponseWithID string = "user/%d/share-invite-monetary-account-response/%d"
+
+	endpointOAuthClient            string = "user/%d/oauth-client"
+	endpointOAuthClientWithID      string = "user/%d/oauth-client/%d"
+	endpointOAuthCallbackURL       string = "user/%d/oauth-client/%d/callback-url"
+	endpointOAuthCallbackURLWithID string = "user/%d/oauth-client/%d/callback-url/%d"
 )
@@ -0,0 +1,73 @@
+package bunq
+
+import (
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type eventService service
+
+// GetAllEvents returns the event feed of the current auth user, newest first: the payments, card actions,
+// requests and other objects of all its accounts in one list. Use EventsOfMonetaryAccount, EventsWithStatus
+// and WithoutUserEvents to filter the events, and the pagination of the response to get more.
+// https://doc.bunq.com/#/event/List_all_Event_for_User
+func (e *eventService) GetAllEvents(params ...model.QueryParam) (*model.ResponseEventsGet, error) {
+	userID, err := e.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := e.client.preformRequest(http.MethodGet, e.client.formatRequestURL(fmt.Sprintf(endpointEvent, userID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseEventsGet
+
+	return &resStruct, e.client.parseResponse(res, &resStruct)
+}
+
+// GetAllOlderEvents calls the older url from the Pagination
+func (e *eventService) GetAllOlderEvents(pagi model.Pagination) (*model.ResponseEventsGet, error) {
+	if pagi.OlderURL == "" {
+		return nil, nil
+	}
+
+	res, err := e.client.preformRequest(http.MethodGet, e.client.formatRequestURL(pagi.OlderURL[len("/v1/"):]), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseEventsGet
+
+	return &resStruct, e.client.parseResponse(res, &resStruct)
+}
+
+// EventsOfMonetaryAccount returns a query parameter for GetAllEvents, that will return only the events of the given account.
+func EventsOfMonetaryAccount(monetaryAccountID int) model.QueryParam {
+	return func(query url.Values) error {
+		query.Set("monetary_account_id", strconv.Itoa(monetaryAccountID))
+		return nil
+	}
+}
+
+// EventsWithStatus returns a query parameter for GetAllEvents, that will return only the events with the given status,
+// model.EventStatusAwaitingReply or model.EventStatusFinalized.
+func EventsWithStatus(status string) model.QueryParam {
+	return func(query url.Values) error {
+		query.Set("status", status)
+		return nil
+	}
+}
+
+// WithoutUserEvents returns a query parameter for GetAllEvents, that will leave out the events of the user itself,
+// which don't belong to a monetary account.
+func WithoutUserEvents() model.QueryParam {
+	return func(query url.Values) error {
+		query.Set("display_user_event", "false")
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package bunq
+
+import (
+	"github.com/d0x7/go-bunq/model"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllEvents(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.EventService.GetAllEvents(EventsOfMonetaryAccount(9512), EventsWithStatus(model.EventStatusFinalized), WithoutUserEvents())
+	assert.NoError(t, err)
+
+	events := res.Events()
+	require.Len(t, events, 4)
+
+	payment, ok := events[0].Object.Object().(*model.Payment)
+	require.True(t, ok)
+	assert.Equal(t, "Payment", events[0].Object.Type())
+	assert.Equal(t, "-12.50 EUR", payment.Amount.String())
+	assert.Equal(t, "Grace Hopper", payment.CounterpartyAlias.DisplayName)
+
+	require.NotNil(t, events[1].Object.MasterCardAction)
+	assert.Equal(t, "Coffee Corner", events[1].Object.MasterCardAction.Description)
+
+	assert.Equal(t, model.EventStatusAwaitingReply, events[2].Status)
+	assert.Equal(t, "RequestInquiry", events[2].Object.Type())
+
+	// Objects of other types are not decoded.
+	assert.Nil(t, events[3].Object.Object())
+	assert.Equal(t, "", events[3].Object.Type())
+
+	older, err := c.EventService.GetAllOlderEvents(res.Pagination)
+	assert.NoError(t, err)
+	assert.Len(t, older.Events(), 4)
+}
+
+func TestEventQueryParams(t *testing.T) {
+	t.Parallel()
+
+	query := url.Values{}
+	for _, p := range []model.QueryParam{EventsOfMonetaryAccount(9512), EventsWithStatus(model.EventStatusAwaitingReply), WithoutUserEvents()} {
+		require.NoError(t, p(query))
+	}
+
+	assert.Equal(t, "display_user_event=false&monetary_account_id=9512&status=AWAITING_REPLY", query.Encode())
+}
@@ -0,0 +1,187 @@
+package bunq
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type internationalService service
+
+// CreateCurrencyConversionQuote requests a quote to convert money from the given account to another account of
+// the user in a different currency. Its rate and fee are in the quote returned by GetCurrencyConversionQuote.
+// https://doc.bunq.com/#/currency-conversion-quote/CREATE_CurrencyConversionQuote_for_User_MonetaryAccount
+func (i *internationalService) CreateCurrencyConversionQuote(monetaryAccountID int, create model.CurrencyConversionQuoteCreate) (*model.ResponseBunqID, error) {
+	if err := create.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return i.client.doCURequest(i.client.formatRequestURL(fmt.Sprintf(endpointCurrencyConversionQuote, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// GetCurrencyConversionQuote returns a currency conversion quote of the given account, with its amounts, rate and expiry.
+// https://doc.bunq.com/#/currency-conversion-quote/READ_CurrencyConversionQuote_for_User_MonetaryAccount
+func (i *internationalService) GetCurrencyConversionQuote(monetaryAccountID, quoteID int) (*model.ResponseCurrencyConversionQuotesGet, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointCurrencyConversionQuoteWithID, userID, monetaryAccountID, quoteID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseCurrencyConversionQuotesGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
+
+// AcceptCurrencyConversionQuote accepts a currency conversion quote of the given account, which converts the money.
+// It returns ErrQuoteExpired without accepting the quote if it has expired.
+// https://doc.bunq.com/#/currency-conversion-quote/UPDATE_CurrencyConversionQuote_for_User_MonetaryAccount
+func (i *internationalService) AcceptCurrencyConversionQuote(monetaryAccountID, quoteID int) (*model.ResponseBunqID, error) {
+	res, err := i.GetCurrencyConversionQuote(monetaryAccountID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := res.CurrencyConversionQuotes()
+	if len(quotes) == 0 {
+		return nil, errors.Errorf("bunq: currency conversion quote %d not found", quoteID)
+	}
+
+	if quotes[0].Expired(time.Now()) {
+		return nil, errors.Wrapf(ErrQuoteExpired, "currency conversion quote %d expired at %s", quoteID, quotes[0].TimeExpiry)
+	}
+
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(model.RequestCurrencyConversionQuoteStatus{Status: model.CurrencyConversionQuoteStatusAccepted})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return i.client.doCURequest(i.client.formatRequestURL(fmt.Sprintf(endpointCurrencyConversionQuoteWithID, userID, monetaryAccountID, quoteID)), bodyRaw, http.MethodPut)
+}
+
+// CreateTransferwiseQuote requests a quote of Wise for an international transfer. Its rate and fee are in the quote
+// returned by GetTransferwiseQuote.
+// https://doc.bunq.com/#/transferwise-quote/CREATE_TransferwiseQuote_for_User
+func (i *internationalService) CreateTransferwiseQuote(create model.TransferwiseQuoteCreate) (*model.ResponseBunqID, error) {
+	if err := create.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return i.client.doCURequest(i.client.formatRequestURL(fmt.Sprintf(endpointTransferwiseQuote, userID)), bodyRaw, http.MethodPost)
+}
+
+// GetTransferwiseQuote returns a Wise quote with its amounts, rate, fee and expiry.
+// https://doc.bunq.com/#/transferwise-quote/READ_TransferwiseQuote_for_User
+func (i *internationalService) GetTransferwiseQuote(quoteID int) (*model.ResponseTransferwiseQuotesGet, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointTransferwiseQuoteWithID, userID, quoteID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseTransferwiseQuotesGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
+
+// CreateTransferwiseRecipient creates the recipient of a transfer for a Wise quote, see model.NewTransferwiseRecipient.
+// https://doc.bunq.com/#/transferwise-recipient/CREATE_TransferwiseRecipient_for_User_TransferwiseQuote
+func (i *internationalService) CreateTransferwiseRecipient(quoteID int, create model.TransferwiseRecipientCreate) (*model.ResponseBunqID, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return i.client.doCURequest(i.client.formatRequestURL(fmt.Sprintf(endpointTransferwiseRecipient, userID, quoteID)), bodyRaw, http.MethodPost)
+}
+
+// CreateTransferwiseTransfer creates the international transfer of a Wise quote, which pays the source amount from the account.
+// It returns ErrQuoteExpired without creating the transfer if the quote has expired.
+// https://doc.bunq.com/#/transferwise-transfer/CREATE_TransferwiseTransfer_for_User_TransferwiseQuote
+func (i *internationalService) CreateTransferwiseTransfer(quoteID int, create model.TransferwiseTransferCreate) (*model.ResponseBunqID, error) {
+	res, err := i.GetTransferwiseQuote(quoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := res.TransferwiseQuotes()
+	if len(quotes) == 0 {
+		return nil, errors.Errorf("bunq: Wise quote %d not found", quoteID)
+	}
+
+	if quotes[0].Expired(time.Now()) {
+		return nil, errors.Wrapf(ErrQuoteExpired, "Wise quote %d expired at %s", quoteID, quotes[0].TimeExpiry)
+	}
+
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return i.client.doCURequest(i.client.formatRequestURL(fmt.Sprintf(endpointTransferwiseTransfer, userID, quoteID)), bodyRaw, http.MethodPost)
+}
+
+// GetTransferwiseTransfer returns the international transfer of a Wise quote, to track its status.
+// https://doc.bunq.com/#/transferwise-transfer/READ_TransferwiseTransfer_for_User_TransferwiseQuote
+func (i *internationalService) GetTransferwiseTransfer(quoteID, transferID int) (*model.ResponseTransferwiseTransfersGet, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointTransferwiseTransferWithID, userID, quoteID, transferID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseTransferwiseTransfersGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
@@ -0,0 +1,99 @@
+package bunq
+
+import (
+	"github.com/d0x7/go-bunq/model"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyConversionQuote(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	usd, err := model.IBANPointer("NL61BUNQ2290361512", "Barrett Bikes USD")
+	require.NoError(t, err)
+
+	res, err := c.InternationalService.CreateCurrencyConversionQuote(9512, model.CurrencyConversionQuoteCreate{
+		Amount:            model.MustParseAmount("1000.00 EUR"),
+		CurrencySource:    "EUR",
+		CurrencyTarget:    "USD",
+		OrderType:         model.CurrencyConversionOrderTypeSell,
+		CounterpartyAlias: usd,
+	})
+	assert.NoError(t, err)
+	assert.NotZero(t, res.ID())
+
+	quotes, err := c.InternationalService.GetCurrencyConversionQuote(9512, 61)
+	assert.NoError(t, err)
+	require.Len(t, quotes.CurrencyConversionQuotes(), 1)
+
+	quote := quotes.CurrencyConversionQuotes()[0]
+	assert.Equal(t, "1083.20 USD", quote.AmountTarget.String())
+	assert.Equal(t, "2.50 EUR", quote.AmountFee.String())
+	assert.Equal(t, "1.08590", quote.Rate)
+
+	_, err = c.InternationalService.AcceptCurrencyConversionQuote(9512, 61)
+	assert.NoError(t, err)
+
+	_, err = c.InternationalService.AcceptCurrencyConversionQuote(9512, 62)
+	assert.True(t, errors.Is(err, ErrQuoteExpired), err)
+}
+
+func TestTransferwiseTransfer(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	target := model.MustParseAmount("427.81 GBP")
+	res, err := c.InternationalService.CreateTransferwiseQuote(model.TransferwiseQuoteCreate{
+		CurrencySource: "EUR",
+		CurrencyTarget: "GBP",
+		AmountTarget:   &target,
+	})
+	assert.NoError(t, err)
+	assert.NotZero(t, res.ID())
+
+	quotes, err := c.InternationalService.GetTransferwiseQuote(71)
+	assert.NoError(t, err)
+	require.Len(t, quotes.TransferwiseQuotes(), 1)
+	assert.Equal(t, "3.21 EUR", quotes.TransferwiseQuotes()[0].AmountFee.String())
+	assert.Equal(t, "0.85725", quotes.TransferwiseQuotes()[0].Rate)
+
+	transfers, err := c.InternationalService.GetTransferwiseTransfer(71, 81)
+	assert.NoError(t, err)
+	require.Len(t, transfers.TransferwiseTransfers(), 1)
+
+	transfer := transfers.TransferwiseTransfers()[0]
+	assert.Equal(t, "processing", transfer.StatusTransferwise)
+	assert.Equal(t, "427.81 GBP", transfer.AmountTarget.String())
+	assert.Equal(t, 71, transfer.Quote.ID)
+
+	// A new transfer to the same counterparty, with the Wise details bunq sent for it.
+	create, err := model.NewTransferwiseRecipient(transfer.CounterpartyAlias, "GB", "sort_code")
+	assert.NoError(t, err)
+
+	recipient, err := c.InternationalService.CreateTransferwiseRecipient(71, create)
+	assert.NoError(t, err)
+
+	_, err = c.InternationalService.CreateTransferwiseTransfer(71, model.TransferwiseTransferCreate{
+		MonetaryAccountID: 9512,
+		RecipientID:       strconv.Itoa(recipient.ID()),
+	})
+	assert.NoError(t, err)
+
+	_, err = c.InternationalService.CreateTransferwiseTransfer(72, model.TransferwiseTransferCreate{MonetaryAccountID: 9512, RecipientID: "1"})
+	assert.True(t, errors.Is(err, ErrQuoteExpired), err)
+}
@@ -0,0 +1,141 @@
+package bunq
+
+import (
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type invoiceService service
+
+// GetAllUserInvoices returns the invoices bunq sent to the current auth user, for its subscription and the fees of all its accounts.
+// https://doc.bunq.com/#/invoice/List_all_Invoice_for_User
+func (i *invoiceService) GetAllUserInvoices(params ...model.QueryParam) (*model.ResponseInvoicesGet, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointInvoiceByUser, userID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseInvoicesGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
+
+// GetUserInvoice returns an invoice of the current auth user with its items, VAT and amounts.
+// https://doc.bunq.com/#/invoice/READ_Invoice_for_User
+func (i *invoiceService) GetUserInvoice(invoiceID int) (*model.ResponseInvoicesGet, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointInvoiceByUserWithID, userID, invoiceID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseInvoicesGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
+
+// GetAllInvoices returns the invoices for the fees of the given account.
+// https://doc.bunq.com/#/invoice/List_all_Invoice_for_User_MonetaryAccount
+func (i *invoiceService) GetAllInvoices(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseInvoicesGet, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointInvoice, userID, monetaryAccountID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseInvoicesGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
+
+// GetInvoice returns an invoice for the fees of the given account with its items, VAT and amounts.
+// https://doc.bunq.com/#/invoice/READ_Invoice_for_User_MonetaryAccount
+func (i *invoiceService) GetInvoice(monetaryAccountID, invoiceID int) (*model.ResponseInvoicesGet, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointInvoiceWithID, userID, monetaryAccountID, invoiceID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseInvoicesGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
+
+// GetAllOlderInvoices calls the older url from the Pagination
+func (i *invoiceService) GetAllOlderInvoices(pagi model.Pagination) (*model.ResponseInvoicesGet, error) {
+	if pagi.OlderURL == "" {
+		return nil, nil
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(pagi.OlderURL[len("/v1/"):]), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseInvoicesGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
+
+// GetInvoicePDF returns the PDF of an invoice of the current auth user.
+// https://doc.bunq.com/#/invoice-export-pdf/List_all_Content_for_User_Invoice_PdfContent
+func (i *invoiceService) GetInvoicePDF(invoiceID int) ([]byte, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointInvoicePDFContent, userID, invoiceID)), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: request to get invoice pdf failed")
+	}
+	defer res.Body.Close()
+
+	pdf, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not read invoice pdf")
+	}
+
+	return pdf, nil
+}
+
+// GetAllBillingContracts returns the subscriptions of the current auth user, past and present.
+// Invoice.BillingContract finds the one an invoice was sent for.
+// https://doc.bunq.com/#/billing-contract-subscription/List_all_BillingContractSubscription_for_User
+func (i *invoiceService) GetAllBillingContracts(params ...model.QueryParam) (*model.ResponseBillingContractsGet, error) {
+	userID, err := i.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.client.preformRequest(http.MethodGet, i.client.formatRequestURL(fmt.Sprintf(endpointBillingContractSubscription, userID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseBillingContractsGet
+
+	return &resStruct, i.client.parseResponse(res, &resStruct)
+}
@@ -0,0 +1,104 @@
+package bunq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const invoicePDF = "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n1 0 obj << /Type /Catalog >> endobj\n%%EOF"
+
+func TestGetAllUserInvoices(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.InvoiceService.GetAllUserInvoices()
+	assert.NoError(t, err)
+
+	invoices := res.Invoices()
+	require.Len(t, invoices, 2)
+	assert.Equal(t, "2019-07-00017", invoices[0].InvoiceNumber)
+	assert.Equal(t, "10.49 EUR", invoices[0].TotalVatInclusive.String())
+	assert.Equal(t, "1.82 EUR", invoices[0].TotalVat.String())
+	assert.Equal(t, "NL851362359B01", invoices[0].VatNumber)
+
+	items := invoices[0].Items()
+	require.Len(t, items, 2)
+	assert.Equal(t, "Foreign card transaction", items[1].TypeDescription)
+	assert.Equal(t, 0.21, items[1].Vat)
+	assert.Equal(t, float64(2), items[1].Quantity)
+	assert.Equal(t, "0.50 EUR", items[1].TotalVatInclusive.String())
+
+	older, err := c.InvoiceService.GetAllOlderInvoices(res.Pagination)
+	assert.NoError(t, err)
+	assert.Len(t, older.Invoices(), 2)
+}
+
+func TestGetInvoice(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.InvoiceService.GetUserInvoice(8012)
+	assert.NoError(t, err)
+	assert.Equal(t, 8012, res.Invoices()[0].ID)
+
+	res, err = c.InvoiceService.GetAllInvoices(9512)
+	assert.NoError(t, err)
+	assert.Len(t, res.Invoices(), 2)
+
+	res, err = c.InvoiceService.GetInvoice(9512, 8012)
+	assert.NoError(t, err)
+	assert.Equal(t, "Barrett Bikes", res.Invoices()[0].CounterpartyAlias.DisplayName)
+}
+
+func TestGetInvoicePDF(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	pdf, err := c.InvoiceService.GetInvoicePDF(8012)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(invoicePDF), pdf)
+}
+
+func TestInvoiceBillingContract(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	contracts, err := c.InvoiceService.GetAllBillingContracts()
+	assert.NoError(t, err)
+	require.Len(t, contracts.BillingContracts(), 2)
+
+	invoices, err := c.InvoiceService.GetAllUserInvoices()
+	assert.NoError(t, err)
+
+	contract, ok, err := invoices.Invoices()[0].BillingContract(contracts.BillingContracts())
+	assert.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "COMPANY_V2", contract.SubscriptionType)
+
+	contract, ok, err = invoices.Invoices()[1].BillingContract(contracts.BillingContracts())
+	assert.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "COMPANY_V1", contract.SubscriptionType)
+}
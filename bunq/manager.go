@@ -0,0 +1,352 @@
+package bunq
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// idleCheckInterval is how often the manager looks for idle clients.
+const idleCheckInterval = time.Minute
+
+// Manager manages the clients of many bunq users, the tenants, in one process.
+// Clients are loaded from the ContextStore on first use and share one http.Client.
+// Clients using the same API key share their rate limits.
+type Manager struct {
+	// HTTPClient is used by all clients, http.DefaultClient if nil. It must be set before the first use.
+	HTTPClient *http.Client
+	// Debug enables debug logging of all clients. It must be set before the first use.
+	Debug bool
+
+	ctx         context.Context
+	store       ContextStore
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	tenants  map[string]*tenant
+	limiters map[string]*rateLimiter
+}
+
+// tenant is the client of a tenant, which is being loaded until ready is closed.
+type tenant struct {
+	ready chan struct{}
+
+	client   *Client
+	err      error
+	loadedAt time.Time
+	lastUsed time.Time
+}
+
+// TenantHealth describes the state of the client of a tenant.
+type TenantHealth struct {
+	TenantID string
+	// Loaded reports whether the client is initialised and ready to use.
+	Loaded   bool
+	UserID   int
+	LoadedAt time.Time
+	LastUsed time.Time
+	// Err is the error of the last attempt to load the client, or the last error of its session handling.
+	Err error
+}
+
+// NewManager creates a Manager loading the API contexts of the tenants from store.
+// Clients that were not used for idleTimeout are evicted, which deletes their sessions;
+// an idleTimeout of zero keeps them until they are evicted with Evict or ctx is done.
+func NewManager(ctx context.Context, store ContextStore, idleTimeout time.Duration) *Manager {
+	m := &Manager{
+		ctx:         ctx,
+		store:       store,
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+		tenants:     make(map[string]*tenant),
+		limiters:    make(map[string]*rateLimiter),
+	}
+
+	if idleTimeout > 0 {
+		go m.evictIdleWorker()
+	}
+
+	return m
+}
+
+// Client returns the initialised client of the tenant, loading it from the store if needed.
+// Concurrent calls for a tenant that is being loaded wait for it, until ctx is done.
+// Every request of the client counts as a use, so it is not evicted as idle while it is making requests,
+// but it is still closed by Evict and Close even if it has been handed out; call Client again afterwards.
+func (m *Manager) Client(ctx context.Context, tenantID string) (*Client, error) {
+	m.mu.Lock()
+	t, ok := m.tenants[tenantID]
+	if !ok || t.failed() {
+		t = &tenant{ready: make(chan struct{})}
+		m.tenants[tenantID] = t
+		go m.load(tenantID, t, func(c context.Context) (*Client, error) {
+			clientCtx, err := m.store.Load(c, tenantID)
+			if err != nil {
+				return nil, err
+			}
+
+			client, err := NewClientFromContext(m.ctx, clientCtx)
+			if err != nil {
+				return nil, err
+			}
+			m.setup(client, t)
+
+			return client, client.Init()
+		})
+	}
+	m.mu.Unlock()
+
+	return m.wait(ctx, t)
+}
+
+// Create registers a new API key, e.g. an OAuth access token, for the tenant like CreateContext does,
+// stores its API context and returns its client. An existing client of the tenant is evicted first.
+func (m *Manager) Create(ctx context.Context, tenantID, baseURL, apiKey, deviceDescription string, permittedIps []string) (*Client, error) {
+	key, err := CreateNewKeyPair()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating new key pair")
+	}
+
+	if err := m.Evict(tenantID); err != nil && m.Debug {
+		log.Printf("bunq: manager: %v", err)
+	}
+
+	m.mu.Lock()
+	t := &tenant{ready: make(chan struct{})}
+	m.tenants[tenantID] = t
+	m.mu.Unlock()
+
+	go m.load(tenantID, t, func(c context.Context) (*Client, error) {
+		client := NewClient(m.ctx, baseURL, key, apiKey, deviceDescription, permittedIps)
+		m.setup(client, t)
+
+		if err := client.Init(); err != nil {
+			return client, err
+		}
+
+		clientCtx, err := client.ExportClientContext()
+		if err != nil {
+			return client, err
+		}
+
+		return client, errors.Wrap(m.store.Save(c, tenantID, &clientCtx), "saving client context")
+	})
+
+	return m.wait(ctx, t)
+}
+
+// Evict closes the client of the tenant, which deletes its session. Its API context stays in the store.
+// A client that was handed out before is closed as well, so its requests fail with "bunq: client is closed".
+func (m *Manager) Evict(tenantID string) error {
+	m.mu.Lock()
+	t, ok := m.tenants[tenantID]
+	delete(m.tenants, tenantID)
+	m.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	<-t.ready
+	if t.client == nil {
+		return nil
+	}
+
+	return errors.Wrapf(t.client.Close(), "bunq: could not close client of tenant %q", tenantID)
+}
+
+// EvictIdle evicts the clients that made no request and were not returned by Client for the idle timeout,
+// and returns how many were evicted.
+func (m *Manager) EvictIdle() int {
+	if m.idleTimeout <= 0 {
+		return 0
+	}
+
+	deadline := m.now().Add(-m.idleTimeout)
+
+	var idle []string
+	m.mu.Lock()
+	for id, t := range m.tenants {
+		if t.done() && t.err == nil && t.lastUsed.Before(deadline) {
+			idle = append(idle, id)
+		}
+	}
+	m.mu.Unlock()
+
+	for _, id := range idle {
+		if err := m.Evict(id); err != nil && m.Debug {
+			log.Printf("bunq: manager: %v", err)
+		}
+	}
+
+	return len(idle)
+}
+
+// Close evicts the clients of all tenants.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	ids := make([]string, 0, len(m.tenants))
+	for id := range m.tenants {
+		ids = append(ids, id)
+	}
+	m.mu.Unlock()
+
+	var firstErr error
+	for _, id := range ids {
+		if err := m.Evict(id); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// Health returns the health of all tenants the manager knows, sorted by tenant id.
+func (m *Manager) Health() []TenantHealth {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	health := make([]TenantHealth, 0, len(m.tenants))
+	for id, t := range m.tenants {
+		health = append(health, t.health(id))
+	}
+	sort.Slice(health, func(i, j int) bool { return health[i].TenantID < health[j].TenantID })
+
+	return health
+}
+
+// TenantHealth returns the health of the tenant, and false if the manager does not know it.
+func (m *Manager) TenantHealth(tenantID string) (TenantHealth, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.tenants[tenantID]
+	if !ok {
+		return TenantHealth{}, false
+	}
+
+	return t.health(tenantID), true
+}
+
+// load initialises the client of the tenant with init. Loading is not bound to the context
+// of the caller, as other callers may be waiting for the same tenant.
+func (m *Manager) load(tenantID string, t *tenant, init func(context.Context) (*Client, error)) {
+	client, err := init(m.ctx)
+	if err != nil && client != nil {
+		_ = client.Close()
+		client = nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	defer close(t.ready)
+
+	if err != nil {
+		t.err = errors.Wrapf(err, "bunq: could not load client of tenant %q", tenantID)
+		return
+	}
+
+	t.client = client
+	t.loadedAt = m.now()
+	t.lastUsed = t.loadedAt
+}
+
+func (m *Manager) wait(ctx context.Context, t *tenant) (*Client, error) {
+	select {
+	case <-t.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	m.mu.Lock()
+	t.lastUsed = m.now()
+	m.mu.Unlock()
+
+	return t.client, nil
+}
+
+// setup makes the client use the shared http.Client and the rate limiter of its API key,
+// and marks the tenant as used on every request.
+func (m *Manager) setup(c *Client, t *tenant) {
+	if m.HTTPClient != nil {
+		c.Client = m.HTTPClient
+	}
+	c.Debug = m.Debug
+	c.onRequest = func() {
+		m.mu.Lock()
+		t.lastUsed = m.now()
+		m.mu.Unlock()
+	}
+
+	if c.apiKey == "" {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	limiter, ok := m.limiters[c.apiKey]
+	if !ok {
+		limiter = newRateLimiter()
+		m.limiters[c.apiKey] = limiter
+	}
+	c.rateLimiter = limiter
+}
+
+func (m *Manager) evictIdleWorker() {
+	ticker := time.NewTicker(idleCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+			m.EvictIdle()
+		}
+	}
+}
+
+func (t *tenant) done() bool {
+	select {
+	case <-t.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *tenant) failed() bool {
+	return t.done() && t.err != nil
+}
+
+// health must be called with the lock of the manager held.
+func (t *tenant) health(tenantID string) TenantHealth {
+	h := TenantHealth{TenantID: tenantID}
+	if !t.done() {
+		return h
+	}
+
+	h.Err = t.err
+	if t.client != nil {
+		h.Loaded = true
+		h.UserID, _ = t.client.GetUserID()
+		h.LoadedAt = t.loadedAt
+		h.LastUsed = t.lastUsed
+		if t.client.Err != nil {
+			h.Err = t.client.Err
+		}
+	}
+
+	return h
+}
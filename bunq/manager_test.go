@@ -0,0 +1,187 @@
+package bunq
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createManagerWithFakeServer(t *testing.T, idleTimeout time.Duration, tenantIDs ...string) (*Manager, func()) {
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	require.NoError(t, c.Init())
+
+	clientCtx, err := c.ExportClientContext()
+	require.NoError(t, err)
+	clientCtx.APIKey = "sandbox_api_key"
+
+	store := FileContextStore{Dir: t.TempDir()}
+	for _, id := range tenantIDs {
+		require.NoError(t, store.Save(context.Background(), id, &clientCtx))
+	}
+
+	ctx, cancelManager := context.WithCancel(context.Background())
+	m := NewManager(ctx, store, idleTimeout)
+	m.HTTPClient = fakeServer.Client()
+
+	return m, func() {
+		// Close deletes the sessions before the fake server goes away.
+		assert.NoError(t, m.Close())
+		assert.NoError(t, c.Close())
+		cancelManager()
+		cancel()
+		fakeServer.Close()
+	}
+}
+
+func TestManagerClient(t *testing.T) {
+	t.Parallel()
+
+	m, cleanup := createManagerWithFakeServer(t, 0, "acme")
+	defer cleanup()
+
+	var wg sync.WaitGroup
+	clients := make([]*Client, 5)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			c, err := m.Client(context.Background(), "acme")
+			assert.NoError(t, err)
+			clients[i] = c
+		}(i)
+	}
+	wg.Wait()
+
+	for _, c := range clients {
+		assert.Same(t, clients[0], c)
+	}
+	assert.True(t, clients[0].IsUserPerson())
+	assert.Same(t, m.HTTPClient, clients[0].Client)
+
+	h, ok := m.TenantHealth("acme")
+	require.True(t, ok)
+	assert.True(t, h.Loaded)
+	assert.NoError(t, h.Err)
+	assert.NotZero(t, h.UserID)
+	assert.False(t, h.LastUsed.IsZero())
+
+	assert.NoError(t, m.Evict("acme"))
+	_, ok = m.TenantHealth("acme")
+	assert.False(t, ok)
+
+	_, err := clients[0].AccountService.GetAllMonetaryAccountBank()
+	assert.Error(t, err, "evicted clients are closed")
+
+	c, err := m.Client(context.Background(), "acme")
+	assert.NoError(t, err)
+	assert.NotSame(t, clients[0], c)
+	assert.NoError(t, m.Close())
+}
+
+func TestManagerUnknownTenant(t *testing.T) {
+	t.Parallel()
+
+	m, cleanup := createManagerWithFakeServer(t, 0)
+	defer cleanup()
+
+	_, err := m.Client(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrUnknownTenant)
+
+	health := m.Health()
+	require.Len(t, health, 1)
+	assert.Equal(t, "missing", health[0].TenantID)
+	assert.False(t, health[0].Loaded)
+	assert.ErrorIs(t, health[0].Err, ErrUnknownTenant)
+
+	_, err = m.Client(context.Background(), "../missing")
+	assert.Error(t, err)
+}
+
+func TestManagerSharesRateLimitsPerAPIKey(t *testing.T) {
+	t.Parallel()
+
+	m, cleanup := createManagerWithFakeServer(t, 0, "acme", "globex")
+	defer cleanup()
+
+	acme, err := m.Client(context.Background(), "acme")
+	require.NoError(t, err)
+	globex, err := m.Client(context.Background(), "globex")
+	require.NoError(t, err)
+
+	assert.NotSame(t, acme, globex)
+	assert.Same(t, acme.rateLimiter, globex.rateLimiter)
+}
+
+func TestManagerEvictIdle(t *testing.T) {
+	t.Parallel()
+
+	m, cleanup := createManagerWithFakeServer(t, time.Hour, "acme", "globex")
+	defer cleanup()
+
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	_, err := m.Client(context.Background(), "acme")
+	require.NoError(t, err)
+	_, err = m.Client(context.Background(), "globex")
+	require.NoError(t, err)
+
+	now = now.Add(30 * time.Minute)
+	assert.Zero(t, m.EvictIdle())
+
+	_, err = m.Client(context.Background(), "globex")
+	require.NoError(t, err)
+
+	now = now.Add(45 * time.Minute)
+	assert.Equal(t, 1, m.EvictIdle())
+
+	health := m.Health()
+	require.Len(t, health, 1)
+	assert.Equal(t, "globex", health[0].TenantID)
+}
+
+func TestManagerEvictIdleClientInUse(t *testing.T) {
+	t.Parallel()
+
+	m, cleanup := createManagerWithFakeServer(t, time.Hour, "acme")
+	defer cleanup()
+
+	var mu sync.Mutex
+	now := time.Now()
+	m.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		now = now.Add(d)
+		mu.Unlock()
+	}
+
+	// A long job keeps using the client it got once, past the idle timeout.
+	c, err := m.Client(context.Background(), "acme")
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		advance(45 * time.Minute)
+		_, err := c.AccountService.GetAllMonetaryAccountBank()
+		require.NoError(t, err)
+		assert.Zero(t, m.EvictIdle())
+	}
+
+	h, ok := m.TenantHealth("acme")
+	require.True(t, ok)
+	assert.Equal(t, m.now(), h.LastUsed)
+
+	advance(2 * time.Hour)
+	assert.Equal(t, 1, m.EvictIdle())
+
+	_, err = c.AccountService.GetAllMonetaryAccountBank()
+	assert.Error(t, err, "evicted clients are closed")
+}
@@ -0,0 +1,107 @@
+package bunq
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type notificationFilterService service
+
+// GetUserNotificationFilters returns the URL callbacks of the current auth user.
+// https://doc.bunq.com/#/notification-filter-url-user/List_NotificationFilterUrl_for_User
+func (n *notificationFilterService) GetUserNotificationFilters() (*model.ResponseNotificationFilterURL, error) {
+	userID, err := n.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.get(fmt.Sprintf(endpointNotificationFilterURLUser, userID))
+}
+
+// SetUserNotificationFilters replaces the URL callbacks of the current auth user with the given filters.
+// https://doc.bunq.com/#/notification-filter-url-user/CREATE_NotificationFilterUrl_for_User
+func (n *notificationFilterService) SetUserNotificationFilters(filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error) {
+	userID, err := n.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.set(fmt.Sprintf(endpointNotificationFilterURLUser, userID), filters)
+}
+
+// ClearUserNotificationFilters removes all URL callbacks of the current auth user.
+func (n *notificationFilterService) ClearUserNotificationFilters() error {
+	_, err := n.SetUserNotificationFilters(nil)
+
+	return err
+}
+
+// GetMonetaryAccountNotificationFilters returns the URL callbacks of the given monetary account.
+// https://doc.bunq.com/#/notification-filter-url-monetary-account/List_NotificationFilterUrl_for_User_MonetaryAccount
+func (n *notificationFilterService) GetMonetaryAccountNotificationFilters(monetaryAccountID int) (*model.ResponseNotificationFilterURL, error) {
+	userID, err := n.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.get(fmt.Sprintf(endpointNotificationFilterURLMonetaryAccount, userID, monetaryAccountID))
+}
+
+// SetMonetaryAccountNotificationFilters replaces the URL callbacks of the given monetary account with the given filters.
+// https://doc.bunq.com/#/notification-filter-url-monetary-account/CREATE_NotificationFilterUrl_for_User_MonetaryAccount
+func (n *notificationFilterService) SetMonetaryAccountNotificationFilters(monetaryAccountID int, filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error) {
+	userID, err := n.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.set(fmt.Sprintf(endpointNotificationFilterURLMonetaryAccount, userID, monetaryAccountID), filters)
+}
+
+// ClearMonetaryAccountNotificationFilters removes all URL callbacks of the given monetary account.
+func (n *notificationFilterService) ClearMonetaryAccountNotificationFilters(monetaryAccountID int) error {
+	_, err := n.SetMonetaryAccountNotificationFilters(monetaryAccountID, nil)
+
+	return err
+}
+
+func (n *notificationFilterService) get(endpoint string) (*model.ResponseNotificationFilterURL, error) {
+	res, err := n.client.preformRequest(http.MethodGet, n.client.formatRequestURL(endpoint), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: request to get notification filters failed")
+	}
+
+	var resFilters model.ResponseNotificationFilterURL
+
+	return &resFilters, n.client.parseResponse(res, &resFilters)
+}
+
+func (n *notificationFilterService) set(endpoint string, filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error) {
+	// bunq only clears the filters when it receives an empty list, null is rejected.
+	rBody := model.RequestNotificationFilterURL{NotificationFilters: make([]model.NotificationFilterURLCreate, 0, len(filters))}
+	for _, f := range filters {
+		rBody.NotificationFilters = append(rBody.NotificationFilters, model.NotificationFilterURLCreate{
+			Category:           f.Category,
+			NotificationTarget: f.NotificationTarget,
+		})
+	}
+
+	bodyRaw, err := json.Marshal(rBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	res, err := n.client.preformRequest(http.MethodPost, n.client.formatRequestURL(endpoint), bytes.NewBuffer(bodyRaw))
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: request to set notification filters failed")
+	}
+
+	var resFilters model.ResponseNotificationFilterURL
+
+	return &resFilters, n.client.parseResponse(res, &resFilters)
+}
@@ -0,0 +1,60 @@
+package bunq
+
+import (
+	"testing"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserNotificationFilters(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.NotificationFilterService.GetUserNotificationFilters()
+	assert.NoError(t, err)
+	assert.Len(t, res.Response, 2)
+	assert.Equal(t, model.NotificationCategoryPayment, res.Response[0].NotificationFilterURL.Category)
+	assert.Equal(t, "https://example.com/bunq/callback", res.Response[0].NotificationFilterURL.NotificationTarget)
+
+	_, err = c.NotificationFilterService.SetUserNotificationFilters(model.NewNotificationFilterURLs(
+		"https://example.com/bunq/callback",
+		model.NotificationCategoryPayment,
+		model.NotificationCategoryMutation,
+	))
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.NotificationFilterService.ClearUserNotificationFilters())
+}
+
+func TestMonetaryAccountNotificationFilters(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.NotificationFilterService.GetMonetaryAccountNotificationFilters(9512)
+	assert.NoError(t, err)
+	assert.Equal(t, 2452, res.Response[1].NotificationFilterURL.ID)
+
+	assert.NoError(t, c.NotificationFilterService.ClearMonetaryAccountNotificationFilters(9512))
+}
+
+func TestNewNotificationFilterURLs(t *testing.T) {
+	t.Parallel()
+
+	filters := model.NewNotificationFilterURLs("https://example.com", model.NotificationCategoryRequest, model.NotificationCategoryDraftPayment)
+
+	assert.Equal(t, []model.NotificationFilterURL{
+		{Category: model.NotificationCategoryRequest, NotificationTarget: "https://example.com"},
+		{Category: model.NotificationCategoryDraftPayment, NotificationTarget: "https://example.com"},
+	}, filters)
+}
@@ -0,0 +1,130 @@
+package bunq
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type oauthService service
+
+// CreateOAuthClient registers a new OAuth client for the current auth user.
+// https://doc.bunq.com/#/oauth-client/CREATE_OauthClient_for_User
+func (o *oauthService) CreateOAuthClient() (*model.ResponseBunqID, error) {
+	userID, err := o.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(model.RequestOAuthClient{})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return o.client.doCURequest(o.client.formatRequestURL(fmt.Sprintf(endpointOAuthClient, userID)), bodyRaw, http.MethodPost)
+}
+
+// GetAllOAuthClients returns the OAuth clients of the current auth user.
+// https://doc.bunq.com/#/oauth-client/List_all_OauthClient_for_User
+func (o *oauthService) GetAllOAuthClients(params ...model.QueryParam) (*model.ResponseOAuthClients, error) {
+	userID, err := o.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.client.preformRequest(http.MethodGet, o.client.formatRequestURL(fmt.Sprintf(endpointOAuthClient, userID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseOAuthClients
+
+	return &resStruct, o.client.parseResponse(res, &resStruct)
+}
+
+// GetOAuthClient returns an OAuth client, including its client id, secret and callback URLs.
+// https://doc.bunq.com/#/oauth-client/READ_OauthClient_for_User
+func (o *oauthService) GetOAuthClient(oauthClientID int) (*model.ResponseOAuthClients, error) {
+	userID, err := o.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.client.preformRequest(http.MethodGet, o.client.formatRequestURL(fmt.Sprintf(endpointOAuthClientWithID, userID, oauthClientID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseOAuthClients
+
+	return &resStruct, o.client.parseResponse(res, &resStruct)
+}
+
+// UpdateOAuthClient updates an OAuth client, e.g. to set its status to ACTIVE or CANCELLED.
+// https://doc.bunq.com/#/oauth-client/UPDATE_OauthClient_for_User
+func (o *oauthService) UpdateOAuthClient(oauthClientID int, rBody model.RequestOAuthClient) (*model.ResponseBunqID, error) {
+	userID, err := o.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(rBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return o.client.doCURequest(o.client.formatRequestURL(fmt.Sprintf(endpointOAuthClientWithID, userID, oauthClientID)), bodyRaw, http.MethodPut)
+}
+
+// CreateOAuthCallbackURL adds a URL the OAuth client may redirect users to.
+// https://doc.bunq.com/#/oauth-callback-url/CREATE_OauthCallbackUrl_for_User_OauthClient
+func (o *oauthService) CreateOAuthCallbackURL(oauthClientID int, url string) (*model.ResponseBunqID, error) {
+	userID, err := o.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(model.RequestOAuthCallbackURL{URL: url})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return o.client.doCURequest(o.client.formatRequestURL(fmt.Sprintf(endpointOAuthCallbackURL, userID, oauthClientID)), bodyRaw, http.MethodPost)
+}
+
+// GetAllOAuthCallbackURLs returns the callback URLs of an OAuth client.
+// https://doc.bunq.com/#/oauth-callback-url/List_all_OauthCallbackUrl_for_User_OauthClient
+func (o *oauthService) GetAllOAuthCallbackURLs(oauthClientID int) (*model.ResponseOAuthCallbackURLs, error) {
+	userID, err := o.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.client.preformRequest(http.MethodGet, o.client.formatRequestURL(fmt.Sprintf(endpointOAuthCallbackURL, userID, oauthClientID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseOAuthCallbackURLs
+
+	return &resStruct, o.client.parseResponse(res, &resStruct)
+}
+
+// DeleteOAuthCallbackURL removes a callback URL from an OAuth client.
+// https://doc.bunq.com/#/oauth-callback-url/DELETE_OauthCallbackUrl_for_User_OauthClient
+func (o *oauthService) DeleteOAuthCallbackURL(oauthClientID, callbackURLID int) error {
+	userID, err := o.client.GetUserID()
+	if err != nil {
+		return err
+	}
+
+	res, err := o.client.preformRequest(http.MethodDelete, o.client.formatRequestURL(fmt.Sprintf(endpointOAuthCallbackURLWithID, userID, oauthClientID, callbackURLID)), nil)
+	if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
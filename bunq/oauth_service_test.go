@@ -0,0 +1,61 @@
+package bunq
+
+import (
+	"github.com/d0x7/go-bunq/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOAuthClients(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	created, err := c.OAuthService.CreateOAuthClient()
+	assert.NoError(t, err)
+	assert.NotZero(t, created.ID())
+
+	all, err := c.OAuthService.GetAllOAuthClients()
+	assert.NoError(t, err)
+	require.Len(t, all.OAuthClients(), 1)
+
+	res, err := c.OAuthService.GetOAuthClient(7)
+	assert.NoError(t, err)
+	client := res.OAuthClients()[0]
+	assert.Equal(t, "ACTIVE", client.Status)
+	assert.NotEmpty(t, client.ClientID)
+	assert.NotEmpty(t, client.Secret)
+	require.Len(t, client.CallbackURL, 1)
+	assert.Equal(t, "https://budgeting.example.com/oauth/callback", client.CallbackURL[0].URL)
+
+	updated, err := c.OAuthService.UpdateOAuthClient(7, model.RequestOAuthClient{Status: "CANCELLED"})
+	assert.NoError(t, err)
+	assert.NotZero(t, updated.ID())
+}
+
+func TestOAuthCallbackURLs(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	created, err := c.OAuthService.CreateOAuthCallbackURL(7, "https://budgeting.example.com/oauth/callback")
+	assert.NoError(t, err)
+	assert.NotZero(t, created.ID())
+
+	res, err := c.OAuthService.GetAllOAuthCallbackURLs(7)
+	assert.NoError(t, err)
+	require.Len(t, res.OAuthCallbackURLs(), 1)
+	assert.Equal(t, 12, res.OAuthCallbackURLs()[0].ID)
+
+	assert.NoError(t, c.OAuthService.DeleteOAuthCallbackURL(7, 12))
+}
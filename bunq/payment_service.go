@@ -12,6 +12,10 @@ import (
 type paymentService service
 
 func (p *paymentService) CreateDraftPayment(monetaryAccountID int, rBody model.RequestCreateDraftPayment) (*model.ResponseBunqID, error) {
+	if err := rBody.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, err
@@ -26,6 +30,10 @@ func (p *paymentService) CreateDraftPayment(monetaryAccountID int, rBody model.R
 }
 
 func (p *paymentService) UpdateDraftPayment(id, monetaryAccountID int, rBody model.RequestUpdateDraftPayment) (*model.ResponseBunqID, error) {
+	if err := rBody.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, err
@@ -106,6 +114,10 @@ func (p *paymentService) GetAllOlderPayment(pagi model.Pagination) (*model.Respo
 }
 
 func (p *paymentService) CreatePaymentBatch(monetaryAccountID int, create model.PaymentBatchCreate) (*model.ResponseBunqID, error) {
+	if err := create.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, err
@@ -120,6 +132,10 @@ func (p *paymentService) CreatePaymentBatch(monetaryAccountID int, create model.
 }
 
 func (p *paymentService) CreatePayment(monetaryAccountID int, create model.PaymentCreate) (*model.ResponseBunqID, error) {
+	if err := create.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,43 @@
+package bunq
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimitInterval is the minimal time between two requests to the same endpoint.
+const rateLimitInterval = time.Second
+
+// rateLimiter spaces the requests to each endpoint, so they stay within the rate limit of bunq.
+// The rate limits apply per API key, so clients using the same API key should share a rateLimiter.
+type rateLimiter struct {
+	mu   sync.Mutex
+	next map[string]time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{next: make(map[string]time.Time)}
+}
+
+// reserve reserves the next slot for a request to path and returns how long to wait until it.
+func (l *rateLimiter) reserve(path string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if len(l.next) > 1024 {
+		for p, next := range l.next {
+			if next.Before(now) {
+				delete(l.next, p)
+			}
+		}
+	}
+
+	at := now
+	if next, ok := l.next[path]; ok && next.After(now) {
+		at = next
+	}
+	l.next[path] = at.Add(rateLimitInterval)
+
+	return at.Sub(now)
+}
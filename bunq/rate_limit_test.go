@@ -0,0 +1,19 @@
+package bunq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	l := newRateLimiter()
+
+	assert.Zero(t, l.reserve("/v1/user/1"))
+	assert.InDelta(t, time.Second, l.reserve("/v1/user/1"), float64(50*time.Millisecond))
+	assert.InDelta(t, 2*time.Second, l.reserve("/v1/user/1"), float64(50*time.Millisecond))
+	assert.Zero(t, l.reserve("/v1/user/2"))
+}
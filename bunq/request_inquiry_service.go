@@ -0,0 +1,68 @@
+package bunq
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type requestInquiryService service
+
+// CreateRequestInquiry sends a request for money from the given account.
+// https://doc.bunq.com/#/request-inquiry/Create_RequestInquiry_for_User_MonetaryAccount
+func (r *requestInquiryService) CreateRequestInquiry(monetaryAccountID int, create model.RequestInquiryCreate) (*model.ResponseBunqID, error) {
+	if err := create.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return r.client.doCURequest(r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiry, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// GetAllRequestInquiries returns the requests for money sent from the given account.
+// https://doc.bunq.com/#/request-inquiry/List_all_RequestInquiry_for_User_MonetaryAccount
+func (r *requestInquiryService) GetAllRequestInquiries(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestInquiriesGet, error) {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.client.preformRequest(http.MethodGet, r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiry, userID, monetaryAccountID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseRequestInquiriesGet
+
+	return &resStruct, r.client.parseResponse(res, &resStruct)
+}
+
+// GetRequestInquiry returns a request for money sent from the given account.
+// https://doc.bunq.com/#/request-inquiry/Read_RequestInquiry_for_User_MonetaryAccount
+func (r *requestInquiryService) GetRequestInquiry(monetaryAccountID, requestInquiryID int) (*model.ResponseRequestInquiriesGet, error) {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.client.preformRequest(http.MethodGet, r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiryWithID, userID, monetaryAccountID, requestInquiryID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseRequestInquiriesGet
+
+	return &resStruct, r.client.parseResponse(res, &resStruct)
+}
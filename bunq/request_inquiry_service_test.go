@@ -0,0 +1,57 @@
+package bunq
+
+import (
+	"github.com/d0x7/go-bunq/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRequestInquiry(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	to, err := model.EmailPointer("sugardaddy@bunq.com")
+	require.NoError(t, err)
+
+	res, err := c.RequestInquiryService.CreateRequestInquiry(9512, model.RequestInquiryCreate{
+		AmountInquired:    model.MustParseAmount("500.00 EUR"),
+		CounterpartyAlias: to,
+		Description:       "Test money",
+	})
+	assert.NoError(t, err)
+	assert.NotZero(t, res.ID())
+
+	_, err = c.RequestInquiryService.CreateRequestInquiry(9512, model.RequestInquiryCreate{
+		AmountInquired:    model.MustParseAmount("500.00 EUR"),
+		CounterpartyAlias: model.Pointer{PType: model.PointerTypeEmail, Value: "sugardaddy"},
+	})
+	assert.ErrorIs(t, err, model.ErrInvalidPointer)
+}
+
+func TestGetRequestInquiries(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	all, err := c.RequestInquiryService.GetAllRequestInquiries(9512)
+	assert.NoError(t, err)
+	require.Len(t, all.RequestInquiries(), 1)
+
+	res, err := c.RequestInquiryService.GetRequestInquiry(9512, 1234)
+	assert.NoError(t, err)
+	inquiry := res.RequestInquiries()[0]
+	assert.Equal(t, "ACCEPTED", inquiry.Status)
+	assert.Equal(t, "500.00 EUR", inquiry.AmountInquired.String())
+	assert.True(t, inquiry.TimeExpiry.IsZero())
+}
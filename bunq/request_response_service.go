@@ -1,6 +1,7 @@
 package bunq
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/d0x7/go-bunq/model"
 	"net/http"
@@ -59,3 +60,29 @@ func (p *requestResponseService) GetAllOlderRequestResponses(pagi model.Paginati
 
 	return &resStruct, p.client.parseResponse(res, &resStruct)
 }
+
+// AcceptRequestResponse accepts a request for money the user received, e.g. a pending direct debit, which pays it.
+// https://doc.bunq.com/#/request-response/UPDATE_RequestResponse_for_User_MonetaryAccount
+func (p *requestResponseService) AcceptRequestResponse(monetaryAccountID, requestResponseID int) (*model.ResponseBunqID, error) {
+	return p.setRequestResponseStatus(monetaryAccountID, requestResponseID, model.RequestResponseStatusAccepted)
+}
+
+// RejectRequestResponse rejects a request for money the user received, e.g. a pending direct debit.
+// https://doc.bunq.com/#/request-response/UPDATE_RequestResponse_for_User_MonetaryAccount
+func (p *requestResponseService) RejectRequestResponse(monetaryAccountID, requestResponseID int) (*model.ResponseBunqID, error) {
+	return p.setRequestResponseStatus(monetaryAccountID, requestResponseID, model.RequestResponseStatusRejected)
+}
+
+func (p *requestResponseService) setRequestResponseStatus(monetaryAccountID, requestResponseID int, status string) (*model.ResponseBunqID, error) {
+	userID, err := p.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(model.RequestRequestResponseStatus{Status: status})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return p.client.doCURequest(p.client.formatRequestURL(fmt.Sprintf(endpointRequestResponsesGetWithID, userID, monetaryAccountID, requestResponseID)), bodyRaw, http.MethodPut)
+}
@@ -66,7 +66,7 @@ func createProperSessionServerResponse(r *model.ResponseSessionServer) *model.Re
 
 func (s *sessionServerService) updateClient(r *model.ResponseSessionServer) {
 	s.updateClientToken(r)
-	s.client.updateUserFlag()
+	s.client.updateUser()
 }
 
 func (s *sessionServerService) updateClientToken(r *model.ResponseSessionServer) {
@@ -88,10 +88,16 @@ func (s *sessionServerService) delete() error {
 		return errors.Wrap(err, fmt.Sprintf("bunq: could not create request for  %s", url))
 	}
 
+	if err := s.client.setAllNeededHeader(r); err != nil {
+		return errors.Wrap(err, "bunq: could not set all required headers")
+	}
+
+	// The request bypasses the request queue, so the session can still be deleted while the client shuts down.
 	res, err := s.client.Do(r)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("bunq: request to %s failed", url))
 	}
+	defer res.Body.Close()
 
 	if res.StatusCode > 299 {
 		return errors.New(fmt.Sprintf("bunq: request to delete session resulted in response code: %d", res.StatusCode))
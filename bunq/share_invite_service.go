@@ -0,0 +1,173 @@
+package bunq
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/d0x7/go-bunq/model"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type shareInviteService service
+
+// CreateShareInvite invites someone to get access to the given account via bunq Connect, with the access
+// of create.ShareDetail, e.g. model.ShowOnlyAccess() for an accountant.
+// https://doc.bunq.com/#/share-invite-monetary-account-inquiry/CREATE_ShareInviteMonetaryAccountInquiry_for_User_MonetaryAccount
+func (s *shareInviteService) CreateShareInvite(monetaryAccountID int, create model.ShareInviteCreate) (*model.ResponseBunqID, error) {
+	create.Status = model.ShareInviteStatusPending
+	if err := create.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
+	userID, err := s.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return s.client.doCURequest(s.client.formatRequestURL(fmt.Sprintf(endpointShareInvite, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// GetAllShareInvites returns the share invites sent for the given account, pending, accepted and ended ones.
+// https://doc.bunq.com/#/share-invite-monetary-account-inquiry/List_all_ShareInviteMonetaryAccountInquiry_for_User_MonetaryAccount
+func (s *shareInviteService) GetAllShareInvites(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseShareInvitesGet, error) {
+	userID, err := s.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.preformRequest(http.MethodGet, s.client.formatRequestURL(fmt.Sprintf(endpointShareInvite, userID, monetaryAccountID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseShareInvitesGet
+
+	return &resStruct, s.client.parseResponse(res, &resStruct)
+}
+
+// GetShareInvite returns a share invite sent for the given account.
+// https://doc.bunq.com/#/share-invite-monetary-account-inquiry/READ_ShareInviteMonetaryAccountInquiry_for_User_MonetaryAccount
+func (s *shareInviteService) GetShareInvite(monetaryAccountID, shareInviteID int) (*model.ResponseShareInvitesGet, error) {
+	userID, err := s.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.preformRequest(http.MethodGet, s.client.formatRequestURL(fmt.Sprintf(endpointShareInviteWithID, userID, monetaryAccountID, shareInviteID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseShareInvitesGet
+
+	return &resStruct, s.client.parseResponse(res, &resStruct)
+}
+
+// UpdateShareInvite changes the access or the end date of a share invite sent for the given account.
+// https://doc.bunq.com/#/share-invite-monetary-account-inquiry/UPDATE_ShareInviteMonetaryAccountInquiry_for_User_MonetaryAccount
+func (s *shareInviteService) UpdateShareInvite(monetaryAccountID, shareInviteID int, update model.ShareInviteUpdate) (*model.ResponseBunqID, error) {
+	if err := update.Validate(); err != nil {
+		return nil, errors.Wrap(err, "bunq: invalid body")
+	}
+
+	userID, err := s.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(update)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return s.client.doCURequest(s.client.formatRequestURL(fmt.Sprintf(endpointShareInviteWithID, userID, monetaryAccountID, shareInviteID)), bodyRaw, http.MethodPut)
+}
+
+// RevokeShareInvite takes back a share invite sent for the given account: a pending one is revoked,
+// an accepted one is cancelled, which ends the access.
+func (s *shareInviteService) RevokeShareInvite(monetaryAccountID, shareInviteID int) (*model.ResponseBunqID, error) {
+	res, err := s.GetShareInvite(monetaryAccountID, shareInviteID)
+	if err != nil {
+		return nil, err
+	}
+
+	invites := res.ShareInvites()
+	if len(invites) == 0 {
+		return nil, errors.Errorf("bunq: share invite %d not found", shareInviteID)
+	}
+
+	status := model.ShareInviteStatusCancelled
+	if invites[0].Status == model.ShareInviteStatusPending {
+		status = model.ShareInviteStatusRevoked
+	}
+
+	return s.UpdateShareInvite(monetaryAccountID, shareInviteID, model.ShareInviteUpdate{Status: status})
+}
+
+// GetAllShareInviteResponses returns the share invites the current auth user received.
+// https://doc.bunq.com/#/share-invite-monetary-account-response/List_all_ShareInviteMonetaryAccountResponse_for_User
+func (s *shareInviteService) GetAllShareInviteResponses(params ...model.QueryParam) (*model.ResponseShareInviteResponsesGet, error) {
+	userID, err := s.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.preformRequest(http.MethodGet, s.client.formatRequestURL(fmt.Sprintf(endpointShareInviteResponse, userID)), nil, params...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseShareInviteResponsesGet
+
+	return &resStruct, s.client.parseResponse(res, &resStruct)
+}
+
+// GetShareInviteResponse returns a share invite the current auth user received.
+// https://doc.bunq.com/#/share-invite-monetary-account-response/READ_ShareInviteMonetaryAccountResponse_for_User
+func (s *shareInviteService) GetShareInviteResponse(shareInviteResponseID int) (*model.ResponseShareInviteResponsesGet, error) {
+	userID, err := s.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.preformRequest(http.MethodGet, s.client.formatRequestURL(fmt.Sprintf(endpointShareInviteResponseWithID, userID, shareInviteResponseID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct model.ResponseShareInviteResponsesGet
+
+	return &resStruct, s.client.parseResponse(res, &resStruct)
+}
+
+// AcceptShareInviteResponse accepts a share invite the current auth user received, which gives it access to the account.
+// https://doc.bunq.com/#/share-invite-monetary-account-response/UPDATE_ShareInviteMonetaryAccountResponse_for_User
+func (s *shareInviteService) AcceptShareInviteResponse(shareInviteResponseID int) (*model.ResponseBunqID, error) {
+	return s.setShareInviteResponseStatus(shareInviteResponseID, model.ShareInviteStatusAccepted)
+}
+
+// RejectShareInviteResponse rejects a share invite the current auth user received.
+// https://doc.bunq.com/#/share-invite-monetary-account-response/UPDATE_ShareInviteMonetaryAccountResponse_for_User
+func (s *shareInviteService) RejectShareInviteResponse(shareInviteResponseID int) (*model.ResponseBunqID, error) {
+	return s.setShareInviteResponseStatus(shareInviteResponseID, model.ShareInviteStatusRejected)
+}
+
+func (s *shareInviteService) setShareInviteResponseStatus(shareInviteResponseID int, status string) (*model.ResponseBunqID, error) {
+	userID, err := s.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(model.RequestShareInviteStatus{Status: status})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return s.client.doCURequest(s.client.formatRequestURL(fmt.Sprintf(endpointShareInviteResponseWithID, userID, shareInviteResponseID)), bodyRaw, http.MethodPut)
+}
@@ -0,0 +1,130 @@
+package bunq
+
+import (
+	"github.com/d0x7/go-bunq/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateShareInvite(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	accountant, err := model.EmailPointer("books@vandijk.example")
+	require.NoError(t, err)
+
+	end := model.NewTime(time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC))
+	res, err := c.ShareInviteService.CreateShareInvite(9512, model.ShareInviteCreate{
+		CounterUserAlias: accountant,
+		ShareDetail:      model.ShowOnlyAccess(),
+		EndDate:          &end,
+	})
+	assert.NoError(t, err)
+	assert.NotZero(t, res.ID())
+
+	_, err = c.ShareInviteService.CreateShareInvite(9512, model.ShareInviteCreate{CounterUserAlias: accountant})
+	assert.Error(t, err)
+}
+
+func TestGetAllShareInvites(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.ShareInviteService.GetAllShareInvites(9512)
+	assert.NoError(t, err)
+
+	invites := res.ShareInvites()
+	require.Len(t, invites, 2)
+
+	assert.Equal(t, model.ShareInviteStatusPending, invites[0].Status)
+	assert.Equal(t, model.ShareAccessShowOnly, invites[0].ShareDetail.AccessType())
+	assert.Equal(t, "Van Dijk Accountants", invites[0].CounterUserAlias.DisplayName)
+	assert.Equal(t, 2024, invites[0].EndDate.Year())
+
+	assert.Equal(t, model.ShareAccessFull, invites[1].ShareDetail.AccessType())
+	require.NotNil(t, invites[1].ShareDetail.Payment.Budget)
+	assert.Equal(t, "500.00 EUR", invites[1].ShareDetail.Payment.Budget.Amount.String())
+	assert.Equal(t, model.ShareBudgetFrequencyMonthly, invites[1].ShareDetail.Payment.Budget.Frequency)
+	assert.True(t, invites[1].EndDate.IsZero())
+
+	one, err := c.ShareInviteService.GetShareInvite(9512, 42)
+	assert.NoError(t, err)
+	require.Len(t, one.ShareInvites(), 1)
+	assert.Equal(t, 42, one.ShareInvites()[0].ID)
+}
+
+func TestUpdateShareInvite(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	detail := model.FullAccess(&model.ShareBudget{Amount: model.MustParseAmount("250.00 EUR"), Frequency: model.ShareBudgetFrequencyWeekly})
+	_, err := c.ShareInviteService.UpdateShareInvite(9512, 42, model.ShareInviteUpdate{ShareDetail: &detail})
+	assert.NoError(t, err)
+
+	detail.Payment.Budget.Amount = model.MustParseAmount("0.00 EUR")
+	_, err = c.ShareInviteService.UpdateShareInvite(9512, 42, model.ShareInviteUpdate{ShareDetail: &detail})
+	assert.Error(t, err)
+}
+
+func TestRevokeShareInvite(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	// The fake server checks that the pending invite is revoked and the accepted one cancelled.
+	_, err := c.ShareInviteService.RevokeShareInvite(9512, 41)
+	assert.NoError(t, err)
+
+	_, err = c.ShareInviteService.RevokeShareInvite(9512, 42)
+	assert.NoError(t, err)
+}
+
+func TestShareInviteResponses(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.ShareInviteService.GetAllShareInviteResponses()
+	assert.NoError(t, err)
+
+	invites := res.ShareInviteResponses()
+	require.Len(t, invites, 1)
+	assert.Equal(t, model.ShareAccessDraftOnly, invites[0].ShareDetail.AccessType())
+	assert.Equal(t, "Grace Hopper", invites[0].CounterAlias.DisplayName)
+	assert.Equal(t, "Household account", invites[0].Description)
+
+	_, err = c.ShareInviteService.GetShareInviteResponse(88)
+	assert.NoError(t, err)
+
+	_, err = c.ShareInviteService.AcceptShareInviteResponse(88)
+	assert.NoError(t, err)
+
+	_, err = c.ShareInviteService.RejectShareInviteResponse(88)
+	assert.NoError(t, err)
+}
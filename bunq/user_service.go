@@ -79,3 +79,64 @@ func (u *userService) UpdateUserPerson(rBody model.RequestUserPersonPut) (*model
 
 	return &resBunqID, nil
 }
+
+// GetUser retrieves the current auth user, which is a model.UserPerson, model.UserCompany or model.UserAPIKey.
+// For API key users, the user that granted the key is available with GrantedBy.
+// https://doc.bunq.com/#/user/Read_User
+func (u *userService) GetUser() (model.User, error) {
+	userID, err := u.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.client.preformRequest(http.MethodGet, u.client.formatRequestURL(fmt.Sprintf(endpointUserGet, userID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resUser model.ResponseUser
+	if err := u.client.parseResponse(res, &resUser); err != nil {
+		return nil, err
+	}
+
+	user := resUser.User()
+	if user == nil {
+		return nil, errors.New("bunq: response does not contain a user")
+	}
+
+	return user, nil
+}
+
+// GetUserCompany retrieves the current auth user company.
+// https://doc.bunq.com/#/user-company/Read_UserCompany
+func (u *userService) GetUserCompany() (*model.ResponseUserCompany, error) {
+	userID, err := u.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.client.preformRequest(http.MethodGet, u.client.formatRequestURL(fmt.Sprintf(endpointUserCompanyGet, userID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resUserCompany model.ResponseUserCompany
+
+	return &resUserCompany, u.client.parseResponse(res, &resUserCompany)
+}
+
+// UpdateUserCompany updates the contents of the current auth user company.
+// https://doc.bunq.com/#/user-company/Update_UserCompany
+func (u *userService) UpdateUserCompany(rBody model.RequestUserCompanyPut) (*model.ResponseBunqID, error) {
+	userID, err := u.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(rBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return u.client.doCURequest(u.client.formatRequestURL(fmt.Sprintf(endpointUserCompanyGet, userID)), bodyRaw, http.MethodPut)
+}
@@ -46,3 +46,54 @@ func TestUpdateUserPerson(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotZero(t, res.Response[0].ID.ID)
 }
+
+func TestGetUser(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+	assert.True(t, c.IsUserPerson())
+	assert.False(t, c.IsUserCompany())
+	assert.Equal(t, 6084, c.User().UserID())
+
+	u, err := c.UserService.GetUser()
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.UserTypePerson, u.UserType())
+	assert.NotZero(t, u.UserID())
+	assert.IsType(t, model.UserPerson{}, u)
+}
+
+func TestGetUserCompany(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	r, err := c.UserService.GetUserCompany()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Barrett Bikes B.V.", r.UserCompany().Name)
+	assert.Equal(t, "12345678", r.UserCompany().ChamberOfCommerceNumber)
+}
+
+func TestUpdateUserCompany(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.UserService.UpdateUserCompany(model.RequestUserCompanyPut{PublicNickName: "Bikes"})
+
+	assert.NoError(t, err)
+	assert.NotZero(t, res.ID())
+}
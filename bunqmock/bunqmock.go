@@ -0,0 +1,75 @@
+// Package bunqmock provides mocks of the interfaces of the bunq package, to unit test code that depends on
+// bunq.API or a service interface like bunq.PaymentAPI without HTTP.
+//
+// Every mock has a func field per method, which is called by the method. Methods whose func is nil return
+// ErrNotMocked. The calls are recorded and returned by Calls:
+//
+//	payments := &bunqmock.PaymentAPI{
+//		CreatePaymentFunc: func(monetaryAccountID int, create model.PaymentCreate) (*model.ResponseBunqID, error) {
+//			return &model.ResponseBunqID{Response: []model.WrappedBunqID{{ID: model.BunqID{ID: 1}}}}, nil
+//		},
+//	}
+//
+//	err := payRent(payments) // code under test
+//
+//	calls := payments.Calls()
+//
+// Client mocks bunq.API with a mock of every service. The mocks are generated from the interfaces
+// of the bunq package by gen.go, with go generate.
+//
+// These are generated func field mocks without dependencies, not gomock mocks: there are no expectations
+// or matchers, and they don't work with gomock.Controller. To use gomock, generate mocks with mockgen
+// from bunq/api.go instead:
+//
+//	mockgen -source=bunq/api.go -destination=mock_bunq/api.go -package=mock_bunq
+package bunqmock
+
+//go:generate go run gen.go
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotMocked is returned by methods of mocks whose func is nil.
+var ErrNotMocked = errors.New("bunqmock: method not mocked")
+
+// Call is a call of a method of a mock. Variadic arguments are recorded as one slice.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// recorder records the calls of a mock.
+type recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls of the methods of the mock, in order.
+func (r *recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// Reset forgets the recorded calls.
+func (r *recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func (r *recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+func notMocked(method string) error {
+	return errors.Wrap(ErrNotMocked, method)
+}
@@ -0,0 +1,84 @@
+package bunqmock
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sweep moves everything above the buffer from the first bank account to the savings account, like an application would.
+func sweep(api bunq.API, savingsIBAN string, buffer model.Amount) error {
+	res, err := api.AccountAPI().GetAllMonetaryAccountBank()
+	if err != nil {
+		return err
+	}
+
+	acc := res.MonetaryAccountBanks()[0]
+	excess, err := acc.Balance.Sub(buffer)
+	if err != nil || !excess.IsPositive() {
+		return err
+	}
+
+	to, err := model.IBANPointer(savingsIBAN, "Savings")
+	if err != nil {
+		return err
+	}
+
+	_, err = api.PaymentAPI().CreatePayment(acc.ID, model.PaymentCreate{Amount: excess, CounterpartyAlias: to, Description: "Sweep"})
+
+	return err
+}
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	m := NewClient()
+	m.Account.GetAllMonetaryAccountBankFunc = func(params ...model.QueryParam) (*model.ResponseMonetaryAccountBankGet, error) {
+		var res model.ResponseMonetaryAccountBankGet
+		err := json.Unmarshal([]byte(`{"Response":[{"MonetaryAccountBank":{"id":42,"balance":{"value":"150.00","currency":"EUR"}}}]}`), &res)
+
+		return &res, err
+	}
+	m.Payment.CreatePaymentFunc = func(monetaryAccountID int, create model.PaymentCreate) (*model.ResponseBunqID, error) {
+		return &model.ResponseBunqID{Response: []model.WrappedBunqID{{ID: model.BunqID{ID: 1}}}}, nil
+	}
+
+	require.NoError(t, sweep(m, "NL02ABNA0123456789", model.MustParseAmount("100.00 EUR")))
+
+	calls := m.Payment.Calls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "CreatePayment", calls[0].Method)
+	assert.Equal(t, 42, calls[0].Args[0])
+	assert.Equal(t, "50.00 EUR", calls[0].Args[1].(model.PaymentCreate).Amount.String())
+
+	m.Payment.Reset()
+	assert.Empty(t, m.Payment.Calls())
+}
+
+func TestNotMocked(t *testing.T) {
+	t.Parallel()
+
+	m := &PaymentAPI{}
+
+	count := func(q url.Values) error {
+		q.Set("count", "2")
+		return nil
+	}
+	res, err := m.GetAllPayment(1, count)
+	assert.Nil(t, res)
+	assert.True(t, errors.Is(err, ErrNotMocked), err)
+	assert.ErrorContains(t, err, "PaymentAPI.GetAllPayment")
+
+	calls := m.Calls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, 1, calls[0].Args[0])
+	assert.Len(t, calls[0].Args[1], 1)
+
+	assert.Error(t, NewClient().NotificationFilterAPI().ClearUserNotificationFilters())
+}
@@ -0,0 +1,239 @@
+//go:build ignore
+
+// gen generates mocks.go from the interfaces in bunq/api.go: a mock per service interface and Client
+// for bunq.API, whose methods return the service interfaces. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	src      = "../bunq/api.go"
+	out      = "mocks.go"
+	bunqPath = "github.com/d0x7/go-bunq/bunq"
+)
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type service struct {
+	// field is the name of the field of Client, the name of the interface without the API suffix.
+	field string
+	iface string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, src, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var body bytes.Buffer
+	var services []service
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			it, ok := ts.Type.(*ast.InterfaceType)
+			if !ok {
+				continue
+			}
+
+			if ts.Name.Name == "API" {
+				for _, m := range methods(fset, it) {
+					services = append(services, service{field: strings.TrimSuffix(m.name, "API"), iface: m.results[0]})
+				}
+				continue
+			}
+
+			writeMock(&body, ts.Name.Name, methods(fset, it))
+		}
+	}
+	writeClient(&body, services)
+
+	var file bytes.Buffer
+	file.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage bunqmock\n\nimport (\n")
+	imports := []string{strconv.Quote(bunqPath)}
+	for _, imp := range f.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		if strings.Contains(body.String(), path.Base(p)+".") {
+			imports = append(imports, imp.Path.Value)
+		}
+	}
+	for _, imp := range imports {
+		fmt.Fprintf(&file, "\t%s\n", imp)
+	}
+	file.WriteString(")\n")
+	file.Write(body.Bytes())
+
+	formatted, err := format.Source(file.Bytes())
+	if err != nil {
+		log.Fatalf("invalid generated code: %v\n%s", err, file.Bytes())
+	}
+
+	if err := os.WriteFile(out, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func methods(fset *token.FileSet, it *ast.InterfaceType) []method {
+	var ms []method
+	for _, field := range it.Methods.List {
+		ft, ok := field.Type.(*ast.FuncType)
+		if !ok {
+			log.Fatalf("embedded interfaces are not supported: %s", expr(fset, field.Type))
+		}
+
+		m := method{name: field.Names[0].Name}
+		for _, p := range ft.Params.List {
+			typ := p.Type
+			variadic := false
+			if e, ok := typ.(*ast.Ellipsis); ok {
+				typ, variadic = e.Elt, true
+			}
+
+			names := p.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent("p" + strconv.Itoa(len(m.params)))}
+			}
+			for _, n := range names {
+				m.params = append(m.params, param{name: n.Name, typ: qualify(expr(fset, typ)), variadic: variadic})
+			}
+		}
+		if ft.Results != nil {
+			for _, r := range ft.Results.List {
+				for range max(1, len(r.Names)) {
+					m.results = append(m.results, qualify(expr(fset, r.Type)))
+				}
+			}
+		}
+
+		ms = append(ms, m)
+	}
+
+	return ms
+}
+
+// qualify qualifies the exported types of the bunq package, which are not qualified in api.go.
+func qualify(typ string) string {
+	trimmed := strings.TrimLeft(typ, "*[]")
+	prefix := typ[:len(typ)-len(trimmed)]
+	if trimmed != "" && !strings.Contains(trimmed, ".") && ast.IsExported(trimmed) {
+		return prefix + "bunq." + trimmed
+	}
+
+	return typ
+}
+
+func expr(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, e); err != nil {
+		log.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+func writeMock(w *bytes.Buffer, iface string, ms []method) {
+	fmt.Fprintf(w, "\n// %s is a mock of bunq.%s.\ntype %s struct {\n", iface, iface, iface)
+	for _, m := range ms {
+		fmt.Fprintf(w, "\t%sFunc func(%s) %s\n", m.name, signature(m.params), results(m.results))
+	}
+	fmt.Fprintf(w, "\n\trecorder\n}\n\nvar _ bunq.%s = (*%s)(nil)\n", iface, iface)
+
+	for _, m := range ms {
+		if len(m.results) == 0 || m.results[len(m.results)-1] != "error" {
+			log.Fatalf("%s.%s doesn't return an error", iface, m.name)
+		}
+
+		var args, recorded []string
+		for _, p := range m.params {
+			recorded = append(recorded, p.name)
+			if p.variadic {
+				args = append(args, p.name+"...")
+			} else {
+				args = append(args, p.name)
+			}
+		}
+
+		fmt.Fprintf(w, "\n// %s calls %sFunc, or returns ErrNotMocked if it is nil.\n", m.name, m.name)
+		fmt.Fprintf(w, "func (m *%s) %s(%s) %s {\n", iface, m.name, signature(m.params), results(m.results))
+		fmt.Fprintf(w, "\tm.record(%s)\n", strings.Join(append([]string{strconv.Quote(m.name)}, recorded...), ", "))
+		fmt.Fprintf(w, "\tif m.%sFunc == nil {\n", m.name)
+		var zeros []string
+		for i, r := range m.results[:len(m.results)-1] {
+			fmt.Fprintf(w, "\t\tvar r%d %s\n", i, r)
+			zeros = append(zeros, "r"+strconv.Itoa(i))
+		}
+		zeros = append(zeros, fmt.Sprintf("notMocked(%q)", iface+"."+m.name))
+		fmt.Fprintf(w, "\t\treturn %s\n\t}\n\n", strings.Join(zeros, ", "))
+		fmt.Fprintf(w, "\treturn m.%sFunc(%s)\n}\n", m.name, strings.Join(args, ", "))
+	}
+}
+
+func writeClient(w *bytes.Buffer, services []service) {
+	w.WriteString("\n// Client is a mock of bunq.API with a mock of every service.\ntype Client struct {\n")
+	for _, s := range services {
+		fmt.Fprintf(w, "\t%s *%s\n", s.field, strings.TrimPrefix(s.iface, "bunq."))
+	}
+	w.WriteString("}\n\nvar _ bunq.API = (*Client)(nil)\n")
+
+	w.WriteString("\n// NewClient returns a Client with a mock of every service, none of whose methods are mocked yet.\nfunc NewClient() *Client {\n\treturn &Client{\n")
+	for _, s := range services {
+		fmt.Fprintf(w, "\t\t%s: &%s{},\n", s.field, strings.TrimPrefix(s.iface, "bunq."))
+	}
+	w.WriteString("\t}\n}\n")
+
+	for _, s := range services {
+		name := strings.TrimPrefix(s.iface, "bunq.")
+		fmt.Fprintf(w, "\n// %s returns the mock of the %s.\n", name, name)
+		fmt.Fprintf(w, "func (c *Client) %s() %s { return c.%s }\n", name, s.iface, s.field)
+	}
+}
+
+func signature(params []param) string {
+	var ps []string
+	for _, p := range params {
+		if p.variadic {
+			ps = append(ps, p.name+" ..."+p.typ)
+		} else {
+			ps = append(ps, p.name+" "+p.typ)
+		}
+	}
+
+	return strings.Join(ps, ", ")
+}
+
+func results(rs []string) string {
+	if len(rs) == 1 {
+		return rs[0]
+	}
+
+	return "(" + strings.Join(rs, ", ") + ")"
+}
@@ -0,0 +1,1232 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package bunqmock
+
+import (
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+)
+
+// UserAPI is a mock of bunq.UserAPI.
+type UserAPI struct {
+	GetUserFunc           func() (model.User, error)
+	GetUserPersonFunc     func() (*model.ResponseUserPerson, error)
+	UpdateUserPersonFunc  func(rBody model.RequestUserPersonPut) (*model.ResponseBunqID, error)
+	GetUserCompanyFunc    func() (*model.ResponseUserCompany, error)
+	UpdateUserCompanyFunc func(rBody model.RequestUserCompanyPut) (*model.ResponseBunqID, error)
+
+	recorder
+}
+
+var _ bunq.UserAPI = (*UserAPI)(nil)
+
+// GetUser calls GetUserFunc, or returns ErrNotMocked if it is nil.
+func (m *UserAPI) GetUser() (model.User, error) {
+	m.record("GetUser")
+	if m.GetUserFunc == nil {
+		var r0 model.User
+		return r0, notMocked("UserAPI.GetUser")
+	}
+
+	return m.GetUserFunc()
+}
+
+// GetUserPerson calls GetUserPersonFunc, or returns ErrNotMocked if it is nil.
+func (m *UserAPI) GetUserPerson() (*model.ResponseUserPerson, error) {
+	m.record("GetUserPerson")
+	if m.GetUserPersonFunc == nil {
+		var r0 *model.ResponseUserPerson
+		return r0, notMocked("UserAPI.GetUserPerson")
+	}
+
+	return m.GetUserPersonFunc()
+}
+
+// UpdateUserPerson calls UpdateUserPersonFunc, or returns ErrNotMocked if it is nil.
+func (m *UserAPI) UpdateUserPerson(rBody model.RequestUserPersonPut) (*model.ResponseBunqID, error) {
+	m.record("UpdateUserPerson", rBody)
+	if m.UpdateUserPersonFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("UserAPI.UpdateUserPerson")
+	}
+
+	return m.UpdateUserPersonFunc(rBody)
+}
+
+// GetUserCompany calls GetUserCompanyFunc, or returns ErrNotMocked if it is nil.
+func (m *UserAPI) GetUserCompany() (*model.ResponseUserCompany, error) {
+	m.record("GetUserCompany")
+	if m.GetUserCompanyFunc == nil {
+		var r0 *model.ResponseUserCompany
+		return r0, notMocked("UserAPI.GetUserCompany")
+	}
+
+	return m.GetUserCompanyFunc()
+}
+
+// UpdateUserCompany calls UpdateUserCompanyFunc, or returns ErrNotMocked if it is nil.
+func (m *UserAPI) UpdateUserCompany(rBody model.RequestUserCompanyPut) (*model.ResponseBunqID, error) {
+	m.record("UpdateUserCompany", rBody)
+	if m.UpdateUserCompanyFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("UserAPI.UpdateUserCompany")
+	}
+
+	return m.UpdateUserCompanyFunc(rBody)
+}
+
+// AccountAPI is a mock of bunq.AccountAPI.
+type AccountAPI struct {
+	GetAllMonetaryAccountBankFunc   func(params ...model.QueryParam) (*model.ResponseMonetaryAccountBankGet, error)
+	GetMonetaryAccountBankFunc      func(id int) (*model.ResponseMonetaryAccountBankGet, error)
+	GetAllMonetaryAccountSavingFunc func(params ...model.QueryParam) (*model.ResponseMonetaryAccountSavingGet, error)
+	GetMonetaryAccountSavingFunc    func(id int) (*model.ResponseMonetaryAccountSavingGet, error)
+
+	recorder
+}
+
+var _ bunq.AccountAPI = (*AccountAPI)(nil)
+
+// GetAllMonetaryAccountBank calls GetAllMonetaryAccountBankFunc, or returns ErrNotMocked if it is nil.
+func (m *AccountAPI) GetAllMonetaryAccountBank(params ...model.QueryParam) (*model.ResponseMonetaryAccountBankGet, error) {
+	m.record("GetAllMonetaryAccountBank", params)
+	if m.GetAllMonetaryAccountBankFunc == nil {
+		var r0 *model.ResponseMonetaryAccountBankGet
+		return r0, notMocked("AccountAPI.GetAllMonetaryAccountBank")
+	}
+
+	return m.GetAllMonetaryAccountBankFunc(params...)
+}
+
+// GetMonetaryAccountBank calls GetMonetaryAccountBankFunc, or returns ErrNotMocked if it is nil.
+func (m *AccountAPI) GetMonetaryAccountBank(id int) (*model.ResponseMonetaryAccountBankGet, error) {
+	m.record("GetMonetaryAccountBank", id)
+	if m.GetMonetaryAccountBankFunc == nil {
+		var r0 *model.ResponseMonetaryAccountBankGet
+		return r0, notMocked("AccountAPI.GetMonetaryAccountBank")
+	}
+
+	return m.GetMonetaryAccountBankFunc(id)
+}
+
+// GetAllMonetaryAccountSaving calls GetAllMonetaryAccountSavingFunc, or returns ErrNotMocked if it is nil.
+func (m *AccountAPI) GetAllMonetaryAccountSaving(params ...model.QueryParam) (*model.ResponseMonetaryAccountSavingGet, error) {
+	m.record("GetAllMonetaryAccountSaving", params)
+	if m.GetAllMonetaryAccountSavingFunc == nil {
+		var r0 *model.ResponseMonetaryAccountSavingGet
+		return r0, notMocked("AccountAPI.GetAllMonetaryAccountSaving")
+	}
+
+	return m.GetAllMonetaryAccountSavingFunc(params...)
+}
+
+// GetMonetaryAccountSaving calls GetMonetaryAccountSavingFunc, or returns ErrNotMocked if it is nil.
+func (m *AccountAPI) GetMonetaryAccountSaving(id int) (*model.ResponseMonetaryAccountSavingGet, error) {
+	m.record("GetMonetaryAccountSaving", id)
+	if m.GetMonetaryAccountSavingFunc == nil {
+		var r0 *model.ResponseMonetaryAccountSavingGet
+		return r0, notMocked("AccountAPI.GetMonetaryAccountSaving")
+	}
+
+	return m.GetMonetaryAccountSavingFunc(id)
+}
+
+// PaymentAPI is a mock of bunq.PaymentAPI.
+type PaymentAPI struct {
+	GetPaymentFunc         func(monetaryAccountID int, paymentID int) (*model.ResponsePaymentGet, error)
+	GetAllPaymentFunc      func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponsePaymentGet, error)
+	GetAllOlderPaymentFunc func(pagi model.Pagination) (*model.ResponsePaymentGet, error)
+	CreatePaymentFunc      func(monetaryAccountID int, create model.PaymentCreate) (*model.ResponseBunqID, error)
+	CreatePaymentBatchFunc func(monetaryAccountID int, create model.PaymentBatchCreate) (*model.ResponseBunqID, error)
+	CreateDraftPaymentFunc func(monetaryAccountID int, rBody model.RequestCreateDraftPayment) (*model.ResponseBunqID, error)
+	UpdateDraftPaymentFunc func(id int, monetaryAccountID int, rBody model.RequestUpdateDraftPayment) (*model.ResponseBunqID, error)
+	GetDraftPaymentFunc    func(id int, monetaryAccountID int) (*model.ResponseDraftPaymentGet, error)
+
+	recorder
+}
+
+var _ bunq.PaymentAPI = (*PaymentAPI)(nil)
+
+// GetPayment calls GetPaymentFunc, or returns ErrNotMocked if it is nil.
+func (m *PaymentAPI) GetPayment(monetaryAccountID int, paymentID int) (*model.ResponsePaymentGet, error) {
+	m.record("GetPayment", monetaryAccountID, paymentID)
+	if m.GetPaymentFunc == nil {
+		var r0 *model.ResponsePaymentGet
+		return r0, notMocked("PaymentAPI.GetPayment")
+	}
+
+	return m.GetPaymentFunc(monetaryAccountID, paymentID)
+}
+
+// GetAllPayment calls GetAllPaymentFunc, or returns ErrNotMocked if it is nil.
+func (m *PaymentAPI) GetAllPayment(monetaryAccountID int, params ...model.QueryParam) (*model.ResponsePaymentGet, error) {
+	m.record("GetAllPayment", monetaryAccountID, params)
+	if m.GetAllPaymentFunc == nil {
+		var r0 *model.ResponsePaymentGet
+		return r0, notMocked("PaymentAPI.GetAllPayment")
+	}
+
+	return m.GetAllPaymentFunc(monetaryAccountID, params...)
+}
+
+// GetAllOlderPayment calls GetAllOlderPaymentFunc, or returns ErrNotMocked if it is nil.
+func (m *PaymentAPI) GetAllOlderPayment(pagi model.Pagination) (*model.ResponsePaymentGet, error) {
+	m.record("GetAllOlderPayment", pagi)
+	if m.GetAllOlderPaymentFunc == nil {
+		var r0 *model.ResponsePaymentGet
+		return r0, notMocked("PaymentAPI.GetAllOlderPayment")
+	}
+
+	return m.GetAllOlderPaymentFunc(pagi)
+}
+
+// CreatePayment calls CreatePaymentFunc, or returns ErrNotMocked if it is nil.
+func (m *PaymentAPI) CreatePayment(monetaryAccountID int, create model.PaymentCreate) (*model.ResponseBunqID, error) {
+	m.record("CreatePayment", monetaryAccountID, create)
+	if m.CreatePaymentFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("PaymentAPI.CreatePayment")
+	}
+
+	return m.CreatePaymentFunc(monetaryAccountID, create)
+}
+
+// CreatePaymentBatch calls CreatePaymentBatchFunc, or returns ErrNotMocked if it is nil.
+func (m *PaymentAPI) CreatePaymentBatch(monetaryAccountID int, create model.PaymentBatchCreate) (*model.ResponseBunqID, error) {
+	m.record("CreatePaymentBatch", monetaryAccountID, create)
+	if m.CreatePaymentBatchFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("PaymentAPI.CreatePaymentBatch")
+	}
+
+	return m.CreatePaymentBatchFunc(monetaryAccountID, create)
+}
+
+// CreateDraftPayment calls CreateDraftPaymentFunc, or returns ErrNotMocked if it is nil.
+func (m *PaymentAPI) CreateDraftPayment(monetaryAccountID int, rBody model.RequestCreateDraftPayment) (*model.ResponseBunqID, error) {
+	m.record("CreateDraftPayment", monetaryAccountID, rBody)
+	if m.CreateDraftPaymentFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("PaymentAPI.CreateDraftPayment")
+	}
+
+	return m.CreateDraftPaymentFunc(monetaryAccountID, rBody)
+}
+
+// UpdateDraftPayment calls UpdateDraftPaymentFunc, or returns ErrNotMocked if it is nil.
+func (m *PaymentAPI) UpdateDraftPayment(id int, monetaryAccountID int, rBody model.RequestUpdateDraftPayment) (*model.ResponseBunqID, error) {
+	m.record("UpdateDraftPayment", id, monetaryAccountID, rBody)
+	if m.UpdateDraftPaymentFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("PaymentAPI.UpdateDraftPayment")
+	}
+
+	return m.UpdateDraftPaymentFunc(id, monetaryAccountID, rBody)
+}
+
+// GetDraftPayment calls GetDraftPaymentFunc, or returns ErrNotMocked if it is nil.
+func (m *PaymentAPI) GetDraftPayment(id int, monetaryAccountID int) (*model.ResponseDraftPaymentGet, error) {
+	m.record("GetDraftPayment", id, monetaryAccountID)
+	if m.GetDraftPaymentFunc == nil {
+		var r0 *model.ResponseDraftPaymentGet
+		return r0, notMocked("PaymentAPI.GetDraftPayment")
+	}
+
+	return m.GetDraftPaymentFunc(id, monetaryAccountID)
+}
+
+// ScheduledPaymentAPI is a mock of bunq.ScheduledPaymentAPI.
+type ScheduledPaymentAPI struct {
+	GetAllScheduledPaymentsFunc func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseScheduledPaymentsGet, error)
+	GetScheduledPaymentFunc     func(monetaryAccountID int, scheduledPaymentID int) (*model.ResponseScheduledPaymentsGet, error)
+
+	recorder
+}
+
+var _ bunq.ScheduledPaymentAPI = (*ScheduledPaymentAPI)(nil)
+
+// GetAllScheduledPayments calls GetAllScheduledPaymentsFunc, or returns ErrNotMocked if it is nil.
+func (m *ScheduledPaymentAPI) GetAllScheduledPayments(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseScheduledPaymentsGet, error) {
+	m.record("GetAllScheduledPayments", monetaryAccountID, params)
+	if m.GetAllScheduledPaymentsFunc == nil {
+		var r0 *model.ResponseScheduledPaymentsGet
+		return r0, notMocked("ScheduledPaymentAPI.GetAllScheduledPayments")
+	}
+
+	return m.GetAllScheduledPaymentsFunc(monetaryAccountID, params...)
+}
+
+// GetScheduledPayment calls GetScheduledPaymentFunc, or returns ErrNotMocked if it is nil.
+func (m *ScheduledPaymentAPI) GetScheduledPayment(monetaryAccountID int, scheduledPaymentID int) (*model.ResponseScheduledPaymentsGet, error) {
+	m.record("GetScheduledPayment", monetaryAccountID, scheduledPaymentID)
+	if m.GetScheduledPaymentFunc == nil {
+		var r0 *model.ResponseScheduledPaymentsGet
+		return r0, notMocked("ScheduledPaymentAPI.GetScheduledPayment")
+	}
+
+	return m.GetScheduledPaymentFunc(monetaryAccountID, scheduledPaymentID)
+}
+
+// CardAPI is a mock of bunq.CardAPI.
+type CardAPI struct {
+	GetAllMasterCardActionFunc func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseMasterCardActionGet, error)
+	GetMasterCardActionFunc    func(monetaryAccountID int, id int) (*model.ResponseMasterCardActionGet, error)
+
+	recorder
+}
+
+var _ bunq.CardAPI = (*CardAPI)(nil)
+
+// GetAllMasterCardAction calls GetAllMasterCardActionFunc, or returns ErrNotMocked if it is nil.
+func (m *CardAPI) GetAllMasterCardAction(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseMasterCardActionGet, error) {
+	m.record("GetAllMasterCardAction", monetaryAccountID, params)
+	if m.GetAllMasterCardActionFunc == nil {
+		var r0 *model.ResponseMasterCardActionGet
+		return r0, notMocked("CardAPI.GetAllMasterCardAction")
+	}
+
+	return m.GetAllMasterCardActionFunc(monetaryAccountID, params...)
+}
+
+// GetMasterCardAction calls GetMasterCardActionFunc, or returns ErrNotMocked if it is nil.
+func (m *CardAPI) GetMasterCardAction(monetaryAccountID int, id int) (*model.ResponseMasterCardActionGet, error) {
+	m.record("GetMasterCardAction", monetaryAccountID, id)
+	if m.GetMasterCardActionFunc == nil {
+		var r0 *model.ResponseMasterCardActionGet
+		return r0, notMocked("CardAPI.GetMasterCardAction")
+	}
+
+	return m.GetMasterCardActionFunc(monetaryAccountID, id)
+}
+
+// ContentAPI is a mock of bunq.ContentAPI.
+type ContentAPI struct {
+	GetAttachmentPublicFunc func(id string) (string, error)
+
+	recorder
+}
+
+var _ bunq.ContentAPI = (*ContentAPI)(nil)
+
+// GetAttachmentPublic calls GetAttachmentPublicFunc, or returns ErrNotMocked if it is nil.
+func (m *ContentAPI) GetAttachmentPublic(id string) (string, error) {
+	m.record("GetAttachmentPublic", id)
+	if m.GetAttachmentPublicFunc == nil {
+		var r0 string
+		return r0, notMocked("ContentAPI.GetAttachmentPublic")
+	}
+
+	return m.GetAttachmentPublicFunc(id)
+}
+
+// RequestResponseAPI is a mock of bunq.RequestResponseAPI.
+type RequestResponseAPI struct {
+	GetAllRequestResponsesFunc      func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestResponsesGet, error)
+	GetRequestResponseFunc          func(monetaryAccountID int, requestResponseID int) (*model.ResponseRequestResponsesGet, error)
+	GetAllOlderRequestResponsesFunc func(pagi model.Pagination) (*model.ResponseRequestResponsesGet, error)
+	AcceptRequestResponseFunc       func(monetaryAccountID int, requestResponseID int) (*model.ResponseBunqID, error)
+	RejectRequestResponseFunc       func(monetaryAccountID int, requestResponseID int) (*model.ResponseBunqID, error)
+
+	recorder
+}
+
+var _ bunq.RequestResponseAPI = (*RequestResponseAPI)(nil)
+
+// GetAllRequestResponses calls GetAllRequestResponsesFunc, or returns ErrNotMocked if it is nil.
+func (m *RequestResponseAPI) GetAllRequestResponses(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestResponsesGet, error) {
+	m.record("GetAllRequestResponses", monetaryAccountID, params)
+	if m.GetAllRequestResponsesFunc == nil {
+		var r0 *model.ResponseRequestResponsesGet
+		return r0, notMocked("RequestResponseAPI.GetAllRequestResponses")
+	}
+
+	return m.GetAllRequestResponsesFunc(monetaryAccountID, params...)
+}
+
+// GetRequestResponse calls GetRequestResponseFunc, or returns ErrNotMocked if it is nil.
+func (m *RequestResponseAPI) GetRequestResponse(monetaryAccountID int, requestResponseID int) (*model.ResponseRequestResponsesGet, error) {
+	m.record("GetRequestResponse", monetaryAccountID, requestResponseID)
+	if m.GetRequestResponseFunc == nil {
+		var r0 *model.ResponseRequestResponsesGet
+		return r0, notMocked("RequestResponseAPI.GetRequestResponse")
+	}
+
+	return m.GetRequestResponseFunc(monetaryAccountID, requestResponseID)
+}
+
+// GetAllOlderRequestResponses calls GetAllOlderRequestResponsesFunc, or returns ErrNotMocked if it is nil.
+func (m *RequestResponseAPI) GetAllOlderRequestResponses(pagi model.Pagination) (*model.ResponseRequestResponsesGet, error) {
+	m.record("GetAllOlderRequestResponses", pagi)
+	if m.GetAllOlderRequestResponsesFunc == nil {
+		var r0 *model.ResponseRequestResponsesGet
+		return r0, notMocked("RequestResponseAPI.GetAllOlderRequestResponses")
+	}
+
+	return m.GetAllOlderRequestResponsesFunc(pagi)
+}
+
+// AcceptRequestResponse calls AcceptRequestResponseFunc, or returns ErrNotMocked if it is nil.
+func (m *RequestResponseAPI) AcceptRequestResponse(monetaryAccountID int, requestResponseID int) (*model.ResponseBunqID, error) {
+	m.record("AcceptRequestResponse", monetaryAccountID, requestResponseID)
+	if m.AcceptRequestResponseFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("RequestResponseAPI.AcceptRequestResponse")
+	}
+
+	return m.AcceptRequestResponseFunc(monetaryAccountID, requestResponseID)
+}
+
+// RejectRequestResponse calls RejectRequestResponseFunc, or returns ErrNotMocked if it is nil.
+func (m *RequestResponseAPI) RejectRequestResponse(monetaryAccountID int, requestResponseID int) (*model.ResponseBunqID, error) {
+	m.record("RejectRequestResponse", monetaryAccountID, requestResponseID)
+	if m.RejectRequestResponseFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("RequestResponseAPI.RejectRequestResponse")
+	}
+
+	return m.RejectRequestResponseFunc(monetaryAccountID, requestResponseID)
+}
+
+// RequestInquiryAPI is a mock of bunq.RequestInquiryAPI.
+type RequestInquiryAPI struct {
+	CreateRequestInquiryFunc   func(monetaryAccountID int, create model.RequestInquiryCreate) (*model.ResponseBunqID, error)
+	GetAllRequestInquiriesFunc func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestInquiriesGet, error)
+	GetRequestInquiryFunc      func(monetaryAccountID int, requestInquiryID int) (*model.ResponseRequestInquiriesGet, error)
+
+	recorder
+}
+
+var _ bunq.RequestInquiryAPI = (*RequestInquiryAPI)(nil)
+
+// CreateRequestInquiry calls CreateRequestInquiryFunc, or returns ErrNotMocked if it is nil.
+func (m *RequestInquiryAPI) CreateRequestInquiry(monetaryAccountID int, create model.RequestInquiryCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateRequestInquiry", monetaryAccountID, create)
+	if m.CreateRequestInquiryFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("RequestInquiryAPI.CreateRequestInquiry")
+	}
+
+	return m.CreateRequestInquiryFunc(monetaryAccountID, create)
+}
+
+// GetAllRequestInquiries calls GetAllRequestInquiriesFunc, or returns ErrNotMocked if it is nil.
+func (m *RequestInquiryAPI) GetAllRequestInquiries(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestInquiriesGet, error) {
+	m.record("GetAllRequestInquiries", monetaryAccountID, params)
+	if m.GetAllRequestInquiriesFunc == nil {
+		var r0 *model.ResponseRequestInquiriesGet
+		return r0, notMocked("RequestInquiryAPI.GetAllRequestInquiries")
+	}
+
+	return m.GetAllRequestInquiriesFunc(monetaryAccountID, params...)
+}
+
+// GetRequestInquiry calls GetRequestInquiryFunc, or returns ErrNotMocked if it is nil.
+func (m *RequestInquiryAPI) GetRequestInquiry(monetaryAccountID int, requestInquiryID int) (*model.ResponseRequestInquiriesGet, error) {
+	m.record("GetRequestInquiry", monetaryAccountID, requestInquiryID)
+	if m.GetRequestInquiryFunc == nil {
+		var r0 *model.ResponseRequestInquiriesGet
+		return r0, notMocked("RequestInquiryAPI.GetRequestInquiry")
+	}
+
+	return m.GetRequestInquiryFunc(monetaryAccountID, requestInquiryID)
+}
+
+// NotificationFilterAPI is a mock of bunq.NotificationFilterAPI.
+type NotificationFilterAPI struct {
+	GetUserNotificationFiltersFunc              func() (*model.ResponseNotificationFilterURL, error)
+	SetUserNotificationFiltersFunc              func(filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error)
+	ClearUserNotificationFiltersFunc            func() error
+	GetMonetaryAccountNotificationFiltersFunc   func(monetaryAccountID int) (*model.ResponseNotificationFilterURL, error)
+	SetMonetaryAccountNotificationFiltersFunc   func(monetaryAccountID int, filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error)
+	ClearMonetaryAccountNotificationFiltersFunc func(monetaryAccountID int) error
+
+	recorder
+}
+
+var _ bunq.NotificationFilterAPI = (*NotificationFilterAPI)(nil)
+
+// GetUserNotificationFilters calls GetUserNotificationFiltersFunc, or returns ErrNotMocked if it is nil.
+func (m *NotificationFilterAPI) GetUserNotificationFilters() (*model.ResponseNotificationFilterURL, error) {
+	m.record("GetUserNotificationFilters")
+	if m.GetUserNotificationFiltersFunc == nil {
+		var r0 *model.ResponseNotificationFilterURL
+		return r0, notMocked("NotificationFilterAPI.GetUserNotificationFilters")
+	}
+
+	return m.GetUserNotificationFiltersFunc()
+}
+
+// SetUserNotificationFilters calls SetUserNotificationFiltersFunc, or returns ErrNotMocked if it is nil.
+func (m *NotificationFilterAPI) SetUserNotificationFilters(filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error) {
+	m.record("SetUserNotificationFilters", filters)
+	if m.SetUserNotificationFiltersFunc == nil {
+		var r0 *model.ResponseNotificationFilterURL
+		return r0, notMocked("NotificationFilterAPI.SetUserNotificationFilters")
+	}
+
+	return m.SetUserNotificationFiltersFunc(filters)
+}
+
+// ClearUserNotificationFilters calls ClearUserNotificationFiltersFunc, or returns ErrNotMocked if it is nil.
+func (m *NotificationFilterAPI) ClearUserNotificationFilters() error {
+	m.record("ClearUserNotificationFilters")
+	if m.ClearUserNotificationFiltersFunc == nil {
+		return notMocked("NotificationFilterAPI.ClearUserNotificationFilters")
+	}
+
+	return m.ClearUserNotificationFiltersFunc()
+}
+
+// GetMonetaryAccountNotificationFilters calls GetMonetaryAccountNotificationFiltersFunc, or returns ErrNotMocked if it is nil.
+func (m *NotificationFilterAPI) GetMonetaryAccountNotificationFilters(monetaryAccountID int) (*model.ResponseNotificationFilterURL, error) {
+	m.record("GetMonetaryAccountNotificationFilters", monetaryAccountID)
+	if m.GetMonetaryAccountNotificationFiltersFunc == nil {
+		var r0 *model.ResponseNotificationFilterURL
+		return r0, notMocked("NotificationFilterAPI.GetMonetaryAccountNotificationFilters")
+	}
+
+	return m.GetMonetaryAccountNotificationFiltersFunc(monetaryAccountID)
+}
+
+// SetMonetaryAccountNotificationFilters calls SetMonetaryAccountNotificationFiltersFunc, or returns ErrNotMocked if it is nil.
+func (m *NotificationFilterAPI) SetMonetaryAccountNotificationFilters(monetaryAccountID int, filters []model.NotificationFilterURL) (*model.ResponseNotificationFilterURL, error) {
+	m.record("SetMonetaryAccountNotificationFilters", monetaryAccountID, filters)
+	if m.SetMonetaryAccountNotificationFiltersFunc == nil {
+		var r0 *model.ResponseNotificationFilterURL
+		return r0, notMocked("NotificationFilterAPI.SetMonetaryAccountNotificationFilters")
+	}
+
+	return m.SetMonetaryAccountNotificationFiltersFunc(monetaryAccountID, filters)
+}
+
+// ClearMonetaryAccountNotificationFilters calls ClearMonetaryAccountNotificationFiltersFunc, or returns ErrNotMocked if it is nil.
+func (m *NotificationFilterAPI) ClearMonetaryAccountNotificationFilters(monetaryAccountID int) error {
+	m.record("ClearMonetaryAccountNotificationFilters", monetaryAccountID)
+	if m.ClearMonetaryAccountNotificationFiltersFunc == nil {
+		return notMocked("NotificationFilterAPI.ClearMonetaryAccountNotificationFilters")
+	}
+
+	return m.ClearMonetaryAccountNotificationFiltersFunc(monetaryAccountID)
+}
+
+// OAuthAPI is a mock of bunq.OAuthAPI.
+type OAuthAPI struct {
+	CreateOAuthClientFunc       func() (*model.ResponseBunqID, error)
+	GetAllOAuthClientsFunc      func(params ...model.QueryParam) (*model.ResponseOAuthClients, error)
+	GetOAuthClientFunc          func(oauthClientID int) (*model.ResponseOAuthClients, error)
+	UpdateOAuthClientFunc       func(oauthClientID int, rBody model.RequestOAuthClient) (*model.ResponseBunqID, error)
+	CreateOAuthCallbackURLFunc  func(oauthClientID int, url string) (*model.ResponseBunqID, error)
+	GetAllOAuthCallbackURLsFunc func(oauthClientID int) (*model.ResponseOAuthCallbackURLs, error)
+	DeleteOAuthCallbackURLFunc  func(oauthClientID int, callbackURLID int) error
+
+	recorder
+}
+
+var _ bunq.OAuthAPI = (*OAuthAPI)(nil)
+
+// CreateOAuthClient calls CreateOAuthClientFunc, or returns ErrNotMocked if it is nil.
+func (m *OAuthAPI) CreateOAuthClient() (*model.ResponseBunqID, error) {
+	m.record("CreateOAuthClient")
+	if m.CreateOAuthClientFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("OAuthAPI.CreateOAuthClient")
+	}
+
+	return m.CreateOAuthClientFunc()
+}
+
+// GetAllOAuthClients calls GetAllOAuthClientsFunc, or returns ErrNotMocked if it is nil.
+func (m *OAuthAPI) GetAllOAuthClients(params ...model.QueryParam) (*model.ResponseOAuthClients, error) {
+	m.record("GetAllOAuthClients", params)
+	if m.GetAllOAuthClientsFunc == nil {
+		var r0 *model.ResponseOAuthClients
+		return r0, notMocked("OAuthAPI.GetAllOAuthClients")
+	}
+
+	return m.GetAllOAuthClientsFunc(params...)
+}
+
+// GetOAuthClient calls GetOAuthClientFunc, or returns ErrNotMocked if it is nil.
+func (m *OAuthAPI) GetOAuthClient(oauthClientID int) (*model.ResponseOAuthClients, error) {
+	m.record("GetOAuthClient", oauthClientID)
+	if m.GetOAuthClientFunc == nil {
+		var r0 *model.ResponseOAuthClients
+		return r0, notMocked("OAuthAPI.GetOAuthClient")
+	}
+
+	return m.GetOAuthClientFunc(oauthClientID)
+}
+
+// UpdateOAuthClient calls UpdateOAuthClientFunc, or returns ErrNotMocked if it is nil.
+func (m *OAuthAPI) UpdateOAuthClient(oauthClientID int, rBody model.RequestOAuthClient) (*model.ResponseBunqID, error) {
+	m.record("UpdateOAuthClient", oauthClientID, rBody)
+	if m.UpdateOAuthClientFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("OAuthAPI.UpdateOAuthClient")
+	}
+
+	return m.UpdateOAuthClientFunc(oauthClientID, rBody)
+}
+
+// CreateOAuthCallbackURL calls CreateOAuthCallbackURLFunc, or returns ErrNotMocked if it is nil.
+func (m *OAuthAPI) CreateOAuthCallbackURL(oauthClientID int, url string) (*model.ResponseBunqID, error) {
+	m.record("CreateOAuthCallbackURL", oauthClientID, url)
+	if m.CreateOAuthCallbackURLFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("OAuthAPI.CreateOAuthCallbackURL")
+	}
+
+	return m.CreateOAuthCallbackURLFunc(oauthClientID, url)
+}
+
+// GetAllOAuthCallbackURLs calls GetAllOAuthCallbackURLsFunc, or returns ErrNotMocked if it is nil.
+func (m *OAuthAPI) GetAllOAuthCallbackURLs(oauthClientID int) (*model.ResponseOAuthCallbackURLs, error) {
+	m.record("GetAllOAuthCallbackURLs", oauthClientID)
+	if m.GetAllOAuthCallbackURLsFunc == nil {
+		var r0 *model.ResponseOAuthCallbackURLs
+		return r0, notMocked("OAuthAPI.GetAllOAuthCallbackURLs")
+	}
+
+	return m.GetAllOAuthCallbackURLsFunc(oauthClientID)
+}
+
+// DeleteOAuthCallbackURL calls DeleteOAuthCallbackURLFunc, or returns ErrNotMocked if it is nil.
+func (m *OAuthAPI) DeleteOAuthCallbackURL(oauthClientID int, callbackURLID int) error {
+	m.record("DeleteOAuthCallbackURL", oauthClientID, callbackURLID)
+	if m.DeleteOAuthCallbackURLFunc == nil {
+		return notMocked("OAuthAPI.DeleteOAuthCallbackURL")
+	}
+
+	return m.DeleteOAuthCallbackURLFunc(oauthClientID, callbackURLID)
+}
+
+// BunqMeAPI is a mock of bunq.BunqMeAPI.
+type BunqMeAPI struct {
+	CreateBunqMeTabFunc                func(monetaryAccountID int, create model.BunqMeTabCreate) (*model.ResponseBunqID, error)
+	GetAllBunqMeTabsFunc               func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseBunqMeTabsGet, error)
+	GetBunqMeTabFunc                   func(monetaryAccountID int, bunqMeTabID int) (*model.ResponseBunqMeTabsGet, error)
+	GetBunqMeTabResultInquiriesFunc    func(monetaryAccountID int, bunqMeTabID int) ([]model.BunqMeTabResultInquiry, error)
+	CloseBunqMeTabFunc                 func(monetaryAccountID int, bunqMeTabID int) (*model.ResponseBunqID, error)
+	CreateBunqMeFundraiserProfileFunc  func(create model.BunqMeFundraiserProfileCreate) (*model.ResponseBunqID, error)
+	GetAllBunqMeFundraiserProfilesFunc func(params ...model.QueryParam) (*model.ResponseBunqMeFundraiserProfilesGet, error)
+	GetBunqMeFundraiserProfileFunc     func(profileID int) (*model.ResponseBunqMeFundraiserProfilesGet, error)
+	CloseBunqMeFundraiserProfileFunc   func(profileID int) (*model.ResponseBunqID, error)
+
+	recorder
+}
+
+var _ bunq.BunqMeAPI = (*BunqMeAPI)(nil)
+
+// CreateBunqMeTab calls CreateBunqMeTabFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) CreateBunqMeTab(monetaryAccountID int, create model.BunqMeTabCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateBunqMeTab", monetaryAccountID, create)
+	if m.CreateBunqMeTabFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("BunqMeAPI.CreateBunqMeTab")
+	}
+
+	return m.CreateBunqMeTabFunc(monetaryAccountID, create)
+}
+
+// GetAllBunqMeTabs calls GetAllBunqMeTabsFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) GetAllBunqMeTabs(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseBunqMeTabsGet, error) {
+	m.record("GetAllBunqMeTabs", monetaryAccountID, params)
+	if m.GetAllBunqMeTabsFunc == nil {
+		var r0 *model.ResponseBunqMeTabsGet
+		return r0, notMocked("BunqMeAPI.GetAllBunqMeTabs")
+	}
+
+	return m.GetAllBunqMeTabsFunc(monetaryAccountID, params...)
+}
+
+// GetBunqMeTab calls GetBunqMeTabFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) GetBunqMeTab(monetaryAccountID int, bunqMeTabID int) (*model.ResponseBunqMeTabsGet, error) {
+	m.record("GetBunqMeTab", monetaryAccountID, bunqMeTabID)
+	if m.GetBunqMeTabFunc == nil {
+		var r0 *model.ResponseBunqMeTabsGet
+		return r0, notMocked("BunqMeAPI.GetBunqMeTab")
+	}
+
+	return m.GetBunqMeTabFunc(monetaryAccountID, bunqMeTabID)
+}
+
+// GetBunqMeTabResultInquiries calls GetBunqMeTabResultInquiriesFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) GetBunqMeTabResultInquiries(monetaryAccountID int, bunqMeTabID int) ([]model.BunqMeTabResultInquiry, error) {
+	m.record("GetBunqMeTabResultInquiries", monetaryAccountID, bunqMeTabID)
+	if m.GetBunqMeTabResultInquiriesFunc == nil {
+		var r0 []model.BunqMeTabResultInquiry
+		return r0, notMocked("BunqMeAPI.GetBunqMeTabResultInquiries")
+	}
+
+	return m.GetBunqMeTabResultInquiriesFunc(monetaryAccountID, bunqMeTabID)
+}
+
+// CloseBunqMeTab calls CloseBunqMeTabFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) CloseBunqMeTab(monetaryAccountID int, bunqMeTabID int) (*model.ResponseBunqID, error) {
+	m.record("CloseBunqMeTab", monetaryAccountID, bunqMeTabID)
+	if m.CloseBunqMeTabFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("BunqMeAPI.CloseBunqMeTab")
+	}
+
+	return m.CloseBunqMeTabFunc(monetaryAccountID, bunqMeTabID)
+}
+
+// CreateBunqMeFundraiserProfile calls CreateBunqMeFundraiserProfileFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) CreateBunqMeFundraiserProfile(create model.BunqMeFundraiserProfileCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateBunqMeFundraiserProfile", create)
+	if m.CreateBunqMeFundraiserProfileFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("BunqMeAPI.CreateBunqMeFundraiserProfile")
+	}
+
+	return m.CreateBunqMeFundraiserProfileFunc(create)
+}
+
+// GetAllBunqMeFundraiserProfiles calls GetAllBunqMeFundraiserProfilesFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) GetAllBunqMeFundraiserProfiles(params ...model.QueryParam) (*model.ResponseBunqMeFundraiserProfilesGet, error) {
+	m.record("GetAllBunqMeFundraiserProfiles", params)
+	if m.GetAllBunqMeFundraiserProfilesFunc == nil {
+		var r0 *model.ResponseBunqMeFundraiserProfilesGet
+		return r0, notMocked("BunqMeAPI.GetAllBunqMeFundraiserProfiles")
+	}
+
+	return m.GetAllBunqMeFundraiserProfilesFunc(params...)
+}
+
+// GetBunqMeFundraiserProfile calls GetBunqMeFundraiserProfileFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) GetBunqMeFundraiserProfile(profileID int) (*model.ResponseBunqMeFundraiserProfilesGet, error) {
+	m.record("GetBunqMeFundraiserProfile", profileID)
+	if m.GetBunqMeFundraiserProfileFunc == nil {
+		var r0 *model.ResponseBunqMeFundraiserProfilesGet
+		return r0, notMocked("BunqMeAPI.GetBunqMeFundraiserProfile")
+	}
+
+	return m.GetBunqMeFundraiserProfileFunc(profileID)
+}
+
+// CloseBunqMeFundraiserProfile calls CloseBunqMeFundraiserProfileFunc, or returns ErrNotMocked if it is nil.
+func (m *BunqMeAPI) CloseBunqMeFundraiserProfile(profileID int) (*model.ResponseBunqID, error) {
+	m.record("CloseBunqMeFundraiserProfile", profileID)
+	if m.CloseBunqMeFundraiserProfileFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("BunqMeAPI.CloseBunqMeFundraiserProfile")
+	}
+
+	return m.CloseBunqMeFundraiserProfileFunc(profileID)
+}
+
+// EventAPI is a mock of bunq.EventAPI.
+type EventAPI struct {
+	GetAllEventsFunc      func(params ...model.QueryParam) (*model.ResponseEventsGet, error)
+	GetAllOlderEventsFunc func(pagi model.Pagination) (*model.ResponseEventsGet, error)
+
+	recorder
+}
+
+var _ bunq.EventAPI = (*EventAPI)(nil)
+
+// GetAllEvents calls GetAllEventsFunc, or returns ErrNotMocked if it is nil.
+func (m *EventAPI) GetAllEvents(params ...model.QueryParam) (*model.ResponseEventsGet, error) {
+	m.record("GetAllEvents", params)
+	if m.GetAllEventsFunc == nil {
+		var r0 *model.ResponseEventsGet
+		return r0, notMocked("EventAPI.GetAllEvents")
+	}
+
+	return m.GetAllEventsFunc(params...)
+}
+
+// GetAllOlderEvents calls GetAllOlderEventsFunc, or returns ErrNotMocked if it is nil.
+func (m *EventAPI) GetAllOlderEvents(pagi model.Pagination) (*model.ResponseEventsGet, error) {
+	m.record("GetAllOlderEvents", pagi)
+	if m.GetAllOlderEventsFunc == nil {
+		var r0 *model.ResponseEventsGet
+		return r0, notMocked("EventAPI.GetAllOlderEvents")
+	}
+
+	return m.GetAllOlderEventsFunc(pagi)
+}
+
+// InvoiceAPI is a mock of bunq.InvoiceAPI.
+type InvoiceAPI struct {
+	GetAllUserInvoicesFunc     func(params ...model.QueryParam) (*model.ResponseInvoicesGet, error)
+	GetUserInvoiceFunc         func(invoiceID int) (*model.ResponseInvoicesGet, error)
+	GetAllInvoicesFunc         func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseInvoicesGet, error)
+	GetInvoiceFunc             func(monetaryAccountID int, invoiceID int) (*model.ResponseInvoicesGet, error)
+	GetAllOlderInvoicesFunc    func(pagi model.Pagination) (*model.ResponseInvoicesGet, error)
+	GetInvoicePDFFunc          func(invoiceID int) ([]byte, error)
+	GetAllBillingContractsFunc func(params ...model.QueryParam) (*model.ResponseBillingContractsGet, error)
+
+	recorder
+}
+
+var _ bunq.InvoiceAPI = (*InvoiceAPI)(nil)
+
+// GetAllUserInvoices calls GetAllUserInvoicesFunc, or returns ErrNotMocked if it is nil.
+func (m *InvoiceAPI) GetAllUserInvoices(params ...model.QueryParam) (*model.ResponseInvoicesGet, error) {
+	m.record("GetAllUserInvoices", params)
+	if m.GetAllUserInvoicesFunc == nil {
+		var r0 *model.ResponseInvoicesGet
+		return r0, notMocked("InvoiceAPI.GetAllUserInvoices")
+	}
+
+	return m.GetAllUserInvoicesFunc(params...)
+}
+
+// GetUserInvoice calls GetUserInvoiceFunc, or returns ErrNotMocked if it is nil.
+func (m *InvoiceAPI) GetUserInvoice(invoiceID int) (*model.ResponseInvoicesGet, error) {
+	m.record("GetUserInvoice", invoiceID)
+	if m.GetUserInvoiceFunc == nil {
+		var r0 *model.ResponseInvoicesGet
+		return r0, notMocked("InvoiceAPI.GetUserInvoice")
+	}
+
+	return m.GetUserInvoiceFunc(invoiceID)
+}
+
+// GetAllInvoices calls GetAllInvoicesFunc, or returns ErrNotMocked if it is nil.
+func (m *InvoiceAPI) GetAllInvoices(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseInvoicesGet, error) {
+	m.record("GetAllInvoices", monetaryAccountID, params)
+	if m.GetAllInvoicesFunc == nil {
+		var r0 *model.ResponseInvoicesGet
+		return r0, notMocked("InvoiceAPI.GetAllInvoices")
+	}
+
+	return m.GetAllInvoicesFunc(monetaryAccountID, params...)
+}
+
+// GetInvoice calls GetInvoiceFunc, or returns ErrNotMocked if it is nil.
+func (m *InvoiceAPI) GetInvoice(monetaryAccountID int, invoiceID int) (*model.ResponseInvoicesGet, error) {
+	m.record("GetInvoice", monetaryAccountID, invoiceID)
+	if m.GetInvoiceFunc == nil {
+		var r0 *model.ResponseInvoicesGet
+		return r0, notMocked("InvoiceAPI.GetInvoice")
+	}
+
+	return m.GetInvoiceFunc(monetaryAccountID, invoiceID)
+}
+
+// GetAllOlderInvoices calls GetAllOlderInvoicesFunc, or returns ErrNotMocked if it is nil.
+func (m *InvoiceAPI) GetAllOlderInvoices(pagi model.Pagination) (*model.ResponseInvoicesGet, error) {
+	m.record("GetAllOlderInvoices", pagi)
+	if m.GetAllOlderInvoicesFunc == nil {
+		var r0 *model.ResponseInvoicesGet
+		return r0, notMocked("InvoiceAPI.GetAllOlderInvoices")
+	}
+
+	return m.GetAllOlderInvoicesFunc(pagi)
+}
+
+// GetInvoicePDF calls GetInvoicePDFFunc, or returns ErrNotMocked if it is nil.
+func (m *InvoiceAPI) GetInvoicePDF(invoiceID int) ([]byte, error) {
+	m.record("GetInvoicePDF", invoiceID)
+	if m.GetInvoicePDFFunc == nil {
+		var r0 []byte
+		return r0, notMocked("InvoiceAPI.GetInvoicePDF")
+	}
+
+	return m.GetInvoicePDFFunc(invoiceID)
+}
+
+// GetAllBillingContracts calls GetAllBillingContractsFunc, or returns ErrNotMocked if it is nil.
+func (m *InvoiceAPI) GetAllBillingContracts(params ...model.QueryParam) (*model.ResponseBillingContractsGet, error) {
+	m.record("GetAllBillingContracts", params)
+	if m.GetAllBillingContractsFunc == nil {
+		var r0 *model.ResponseBillingContractsGet
+		return r0, notMocked("InvoiceAPI.GetAllBillingContracts")
+	}
+
+	return m.GetAllBillingContractsFunc(params...)
+}
+
+// ShareInviteAPI is a mock of bunq.ShareInviteAPI.
+type ShareInviteAPI struct {
+	CreateShareInviteFunc          func(monetaryAccountID int, create model.ShareInviteCreate) (*model.ResponseBunqID, error)
+	GetAllShareInvitesFunc         func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseShareInvitesGet, error)
+	GetShareInviteFunc             func(monetaryAccountID int, shareInviteID int) (*model.ResponseShareInvitesGet, error)
+	UpdateShareInviteFunc          func(monetaryAccountID int, shareInviteID int, update model.ShareInviteUpdate) (*model.ResponseBunqID, error)
+	RevokeShareInviteFunc          func(monetaryAccountID int, shareInviteID int) (*model.ResponseBunqID, error)
+	GetAllShareInviteResponsesFunc func(params ...model.QueryParam) (*model.ResponseShareInviteResponsesGet, error)
+	GetShareInviteResponseFunc     func(shareInviteResponseID int) (*model.ResponseShareInviteResponsesGet, error)
+	AcceptShareInviteResponseFunc  func(shareInviteResponseID int) (*model.ResponseBunqID, error)
+	RejectShareInviteResponseFunc  func(shareInviteResponseID int) (*model.ResponseBunqID, error)
+
+	recorder
+}
+
+var _ bunq.ShareInviteAPI = (*ShareInviteAPI)(nil)
+
+// CreateShareInvite calls CreateShareInviteFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) CreateShareInvite(monetaryAccountID int, create model.ShareInviteCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateShareInvite", monetaryAccountID, create)
+	if m.CreateShareInviteFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("ShareInviteAPI.CreateShareInvite")
+	}
+
+	return m.CreateShareInviteFunc(monetaryAccountID, create)
+}
+
+// GetAllShareInvites calls GetAllShareInvitesFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) GetAllShareInvites(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseShareInvitesGet, error) {
+	m.record("GetAllShareInvites", monetaryAccountID, params)
+	if m.GetAllShareInvitesFunc == nil {
+		var r0 *model.ResponseShareInvitesGet
+		return r0, notMocked("ShareInviteAPI.GetAllShareInvites")
+	}
+
+	return m.GetAllShareInvitesFunc(monetaryAccountID, params...)
+}
+
+// GetShareInvite calls GetShareInviteFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) GetShareInvite(monetaryAccountID int, shareInviteID int) (*model.ResponseShareInvitesGet, error) {
+	m.record("GetShareInvite", monetaryAccountID, shareInviteID)
+	if m.GetShareInviteFunc == nil {
+		var r0 *model.ResponseShareInvitesGet
+		return r0, notMocked("ShareInviteAPI.GetShareInvite")
+	}
+
+	return m.GetShareInviteFunc(monetaryAccountID, shareInviteID)
+}
+
+// UpdateShareInvite calls UpdateShareInviteFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) UpdateShareInvite(monetaryAccountID int, shareInviteID int, update model.ShareInviteUpdate) (*model.ResponseBunqID, error) {
+	m.record("UpdateShareInvite", monetaryAccountID, shareInviteID, update)
+	if m.UpdateShareInviteFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("ShareInviteAPI.UpdateShareInvite")
+	}
+
+	return m.UpdateShareInviteFunc(monetaryAccountID, shareInviteID, update)
+}
+
+// RevokeShareInvite calls RevokeShareInviteFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) RevokeShareInvite(monetaryAccountID int, shareInviteID int) (*model.ResponseBunqID, error) {
+	m.record("RevokeShareInvite", monetaryAccountID, shareInviteID)
+	if m.RevokeShareInviteFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("ShareInviteAPI.RevokeShareInvite")
+	}
+
+	return m.RevokeShareInviteFunc(monetaryAccountID, shareInviteID)
+}
+
+// GetAllShareInviteResponses calls GetAllShareInviteResponsesFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) GetAllShareInviteResponses(params ...model.QueryParam) (*model.ResponseShareInviteResponsesGet, error) {
+	m.record("GetAllShareInviteResponses", params)
+	if m.GetAllShareInviteResponsesFunc == nil {
+		var r0 *model.ResponseShareInviteResponsesGet
+		return r0, notMocked("ShareInviteAPI.GetAllShareInviteResponses")
+	}
+
+	return m.GetAllShareInviteResponsesFunc(params...)
+}
+
+// GetShareInviteResponse calls GetShareInviteResponseFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) GetShareInviteResponse(shareInviteResponseID int) (*model.ResponseShareInviteResponsesGet, error) {
+	m.record("GetShareInviteResponse", shareInviteResponseID)
+	if m.GetShareInviteResponseFunc == nil {
+		var r0 *model.ResponseShareInviteResponsesGet
+		return r0, notMocked("ShareInviteAPI.GetShareInviteResponse")
+	}
+
+	return m.GetShareInviteResponseFunc(shareInviteResponseID)
+}
+
+// AcceptShareInviteResponse calls AcceptShareInviteResponseFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) AcceptShareInviteResponse(shareInviteResponseID int) (*model.ResponseBunqID, error) {
+	m.record("AcceptShareInviteResponse", shareInviteResponseID)
+	if m.AcceptShareInviteResponseFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("ShareInviteAPI.AcceptShareInviteResponse")
+	}
+
+	return m.AcceptShareInviteResponseFunc(shareInviteResponseID)
+}
+
+// RejectShareInviteResponse calls RejectShareInviteResponseFunc, or returns ErrNotMocked if it is nil.
+func (m *ShareInviteAPI) RejectShareInviteResponse(shareInviteResponseID int) (*model.ResponseBunqID, error) {
+	m.record("RejectShareInviteResponse", shareInviteResponseID)
+	if m.RejectShareInviteResponseFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("ShareInviteAPI.RejectShareInviteResponse")
+	}
+
+	return m.RejectShareInviteResponseFunc(shareInviteResponseID)
+}
+
+// DirectDebitAPI is a mock of bunq.DirectDebitAPI.
+type DirectDebitAPI struct {
+	GetAllDirectDebitMandatesFunc func(monetaryAccountID int) ([]model.DirectDebitMandate, error)
+	ProcessDirectDebitsFunc       func(monetaryAccountID int, rules model.DirectDebitRules) ([]model.DirectDebitDecision, error)
+	CreateSddWhitelistFunc        func(create model.SddWhitelistCreate) (*model.ResponseBunqID, error)
+	GetAllSddWhitelistsFunc       func(params ...model.QueryParam) (*model.ResponseSddWhitelistsGet, error)
+	GetSddWhitelistFunc           func(whitelistID int) (*model.ResponseSddWhitelistsGet, error)
+	DeleteSddWhitelistFunc        func(whitelistID int) error
+
+	recorder
+}
+
+var _ bunq.DirectDebitAPI = (*DirectDebitAPI)(nil)
+
+// GetAllDirectDebitMandates calls GetAllDirectDebitMandatesFunc, or returns ErrNotMocked if it is nil.
+func (m *DirectDebitAPI) GetAllDirectDebitMandates(monetaryAccountID int) ([]model.DirectDebitMandate, error) {
+	m.record("GetAllDirectDebitMandates", monetaryAccountID)
+	if m.GetAllDirectDebitMandatesFunc == nil {
+		var r0 []model.DirectDebitMandate
+		return r0, notMocked("DirectDebitAPI.GetAllDirectDebitMandates")
+	}
+
+	return m.GetAllDirectDebitMandatesFunc(monetaryAccountID)
+}
+
+// ProcessDirectDebits calls ProcessDirectDebitsFunc, or returns ErrNotMocked if it is nil.
+func (m *DirectDebitAPI) ProcessDirectDebits(monetaryAccountID int, rules model.DirectDebitRules) ([]model.DirectDebitDecision, error) {
+	m.record("ProcessDirectDebits", monetaryAccountID, rules)
+	if m.ProcessDirectDebitsFunc == nil {
+		var r0 []model.DirectDebitDecision
+		return r0, notMocked("DirectDebitAPI.ProcessDirectDebits")
+	}
+
+	return m.ProcessDirectDebitsFunc(monetaryAccountID, rules)
+}
+
+// CreateSddWhitelist calls CreateSddWhitelistFunc, or returns ErrNotMocked if it is nil.
+func (m *DirectDebitAPI) CreateSddWhitelist(create model.SddWhitelistCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateSddWhitelist", create)
+	if m.CreateSddWhitelistFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("DirectDebitAPI.CreateSddWhitelist")
+	}
+
+	return m.CreateSddWhitelistFunc(create)
+}
+
+// GetAllSddWhitelists calls GetAllSddWhitelistsFunc, or returns ErrNotMocked if it is nil.
+func (m *DirectDebitAPI) GetAllSddWhitelists(params ...model.QueryParam) (*model.ResponseSddWhitelistsGet, error) {
+	m.record("GetAllSddWhitelists", params)
+	if m.GetAllSddWhitelistsFunc == nil {
+		var r0 *model.ResponseSddWhitelistsGet
+		return r0, notMocked("DirectDebitAPI.GetAllSddWhitelists")
+	}
+
+	return m.GetAllSddWhitelistsFunc(params...)
+}
+
+// GetSddWhitelist calls GetSddWhitelistFunc, or returns ErrNotMocked if it is nil.
+func (m *DirectDebitAPI) GetSddWhitelist(whitelistID int) (*model.ResponseSddWhitelistsGet, error) {
+	m.record("GetSddWhitelist", whitelistID)
+	if m.GetSddWhitelistFunc == nil {
+		var r0 *model.ResponseSddWhitelistsGet
+		return r0, notMocked("DirectDebitAPI.GetSddWhitelist")
+	}
+
+	return m.GetSddWhitelistFunc(whitelistID)
+}
+
+// DeleteSddWhitelist calls DeleteSddWhitelistFunc, or returns ErrNotMocked if it is nil.
+func (m *DirectDebitAPI) DeleteSddWhitelist(whitelistID int) error {
+	m.record("DeleteSddWhitelist", whitelistID)
+	if m.DeleteSddWhitelistFunc == nil {
+		return notMocked("DirectDebitAPI.DeleteSddWhitelist")
+	}
+
+	return m.DeleteSddWhitelistFunc(whitelistID)
+}
+
+// InternationalAPI is a mock of bunq.InternationalAPI.
+type InternationalAPI struct {
+	CreateCurrencyConversionQuoteFunc func(monetaryAccountID int, create model.CurrencyConversionQuoteCreate) (*model.ResponseBunqID, error)
+	GetCurrencyConversionQuoteFunc    func(monetaryAccountID int, quoteID int) (*model.ResponseCurrencyConversionQuotesGet, error)
+	AcceptCurrencyConversionQuoteFunc func(monetaryAccountID int, quoteID int) (*model.ResponseBunqID, error)
+	CreateTransferwiseQuoteFunc       func(create model.TransferwiseQuoteCreate) (*model.ResponseBunqID, error)
+	GetTransferwiseQuoteFunc          func(quoteID int) (*model.ResponseTransferwiseQuotesGet, error)
+	CreateTransferwiseRecipientFunc   func(quoteID int, create model.TransferwiseRecipientCreate) (*model.ResponseBunqID, error)
+	CreateTransferwiseTransferFunc    func(quoteID int, create model.TransferwiseTransferCreate) (*model.ResponseBunqID, error)
+	GetTransferwiseTransferFunc       func(quoteID int, transferID int) (*model.ResponseTransferwiseTransfersGet, error)
+
+	recorder
+}
+
+var _ bunq.InternationalAPI = (*InternationalAPI)(nil)
+
+// CreateCurrencyConversionQuote calls CreateCurrencyConversionQuoteFunc, or returns ErrNotMocked if it is nil.
+func (m *InternationalAPI) CreateCurrencyConversionQuote(monetaryAccountID int, create model.CurrencyConversionQuoteCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateCurrencyConversionQuote", monetaryAccountID, create)
+	if m.CreateCurrencyConversionQuoteFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("InternationalAPI.CreateCurrencyConversionQuote")
+	}
+
+	return m.CreateCurrencyConversionQuoteFunc(monetaryAccountID, create)
+}
+
+// GetCurrencyConversionQuote calls GetCurrencyConversionQuoteFunc, or returns ErrNotMocked if it is nil.
+func (m *InternationalAPI) GetCurrencyConversionQuote(monetaryAccountID int, quoteID int) (*model.ResponseCurrencyConversionQuotesGet, error) {
+	m.record("GetCurrencyConversionQuote", monetaryAccountID, quoteID)
+	if m.GetCurrencyConversionQuoteFunc == nil {
+		var r0 *model.ResponseCurrencyConversionQuotesGet
+		return r0, notMocked("InternationalAPI.GetCurrencyConversionQuote")
+	}
+
+	return m.GetCurrencyConversionQuoteFunc(monetaryAccountID, quoteID)
+}
+
+// AcceptCurrencyConversionQuote calls AcceptCurrencyConversionQuoteFunc, or returns ErrNotMocked if it is nil.
+func (m *InternationalAPI) AcceptCurrencyConversionQuote(monetaryAccountID int, quoteID int) (*model.ResponseBunqID, error) {
+	m.record("AcceptCurrencyConversionQuote", monetaryAccountID, quoteID)
+	if m.AcceptCurrencyConversionQuoteFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("InternationalAPI.AcceptCurrencyConversionQuote")
+	}
+
+	return m.AcceptCurrencyConversionQuoteFunc(monetaryAccountID, quoteID)
+}
+
+// CreateTransferwiseQuote calls CreateTransferwiseQuoteFunc, or returns ErrNotMocked if it is nil.
+func (m *InternationalAPI) CreateTransferwiseQuote(create model.TransferwiseQuoteCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateTransferwiseQuote", create)
+	if m.CreateTransferwiseQuoteFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("InternationalAPI.CreateTransferwiseQuote")
+	}
+
+	return m.CreateTransferwiseQuoteFunc(create)
+}
+
+// GetTransferwiseQuote calls GetTransferwiseQuoteFunc, or returns ErrNotMocked if it is nil.
+func (m *InternationalAPI) GetTransferwiseQuote(quoteID int) (*model.ResponseTransferwiseQuotesGet, error) {
+	m.record("GetTransferwiseQuote", quoteID)
+	if m.GetTransferwiseQuoteFunc == nil {
+		var r0 *model.ResponseTransferwiseQuotesGet
+		return r0, notMocked("InternationalAPI.GetTransferwiseQuote")
+	}
+
+	return m.GetTransferwiseQuoteFunc(quoteID)
+}
+
+// CreateTransferwiseRecipient calls CreateTransferwiseRecipientFunc, or returns ErrNotMocked if it is nil.
+func (m *InternationalAPI) CreateTransferwiseRecipient(quoteID int, create model.TransferwiseRecipientCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateTransferwiseRecipient", quoteID, create)
+	if m.CreateTransferwiseRecipientFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("InternationalAPI.CreateTransferwiseRecipient")
+	}
+
+	return m.CreateTransferwiseRecipientFunc(quoteID, create)
+}
+
+// CreateTransferwiseTransfer calls CreateTransferwiseTransferFunc, or returns ErrNotMocked if it is nil.
+func (m *InternationalAPI) CreateTransferwiseTransfer(quoteID int, create model.TransferwiseTransferCreate) (*model.ResponseBunqID, error) {
+	m.record("CreateTransferwiseTransfer", quoteID, create)
+	if m.CreateTransferwiseTransferFunc == nil {
+		var r0 *model.ResponseBunqID
+		return r0, notMocked("InternationalAPI.CreateTransferwiseTransfer")
+	}
+
+	return m.CreateTransferwiseTransferFunc(quoteID, create)
+}
+
+// GetTransferwiseTransfer calls GetTransferwiseTransferFunc, or returns ErrNotMocked if it is nil.
+func (m *InternationalAPI) GetTransferwiseTransfer(quoteID int, transferID int) (*model.ResponseTransferwiseTransfersGet, error) {
+	m.record("GetTransferwiseTransfer", quoteID, transferID)
+	if m.GetTransferwiseTransferFunc == nil {
+		var r0 *model.ResponseTransferwiseTransfersGet
+		return r0, notMocked("InternationalAPI.GetTransferwiseTransfer")
+	}
+
+	return m.GetTransferwiseTransferFunc(quoteID, transferID)
+}
+
+// Client is a mock of bunq.API with a mock of every service.
+type Client struct {
+	User               *UserAPI
+	Account            *AccountAPI
+	Payment            *PaymentAPI
+	ScheduledPayment   *ScheduledPaymentAPI
+	Card               *CardAPI
+	Content            *ContentAPI
+	RequestResponse    *RequestResponseAPI
+	RequestInquiry     *RequestInquiryAPI
+	NotificationFilter *NotificationFilterAPI
+	OAuth              *OAuthAPI
+	BunqMe             *BunqMeAPI
+	Event              *EventAPI
+	Invoice            *InvoiceAPI
+	ShareInvite        *ShareInviteAPI
+	DirectDebit        *DirectDebitAPI
+	International      *InternationalAPI
+}
+
+var _ bunq.API = (*Client)(nil)
+
+// NewClient returns a Client with a mock of every service, none of whose methods are mocked yet.
+func NewClient() *Client {
+	return &Client{
+		User:               &UserAPI{},
+		Account:            &AccountAPI{},
+		Payment:            &PaymentAPI{},
+		ScheduledPayment:   &ScheduledPaymentAPI{},
+		Card:               &CardAPI{},
+		Content:            &ContentAPI{},
+		RequestResponse:    &RequestResponseAPI{},
+		RequestInquiry:     &RequestInquiryAPI{},
+		NotificationFilter: &NotificationFilterAPI{},
+		OAuth:              &OAuthAPI{},
+		BunqMe:             &BunqMeAPI{},
+		Event:              &EventAPI{},
+		Invoice:            &InvoiceAPI{},
+		ShareInvite:        &ShareInviteAPI{},
+		DirectDebit:        &DirectDebitAPI{},
+		International:      &InternationalAPI{},
+	}
+}
+
+// UserAPI returns the mock of the UserAPI.
+func (c *Client) UserAPI() bunq.UserAPI { return c.User }
+
+// AccountAPI returns the mock of the AccountAPI.
+func (c *Client) AccountAPI() bunq.AccountAPI { return c.Account }
+
+// PaymentAPI returns the mock of the PaymentAPI.
+func (c *Client) PaymentAPI() bunq.PaymentAPI { return c.Payment }
+
+// ScheduledPaymentAPI returns the mock of the ScheduledPaymentAPI.
+func (c *Client) ScheduledPaymentAPI() bunq.ScheduledPaymentAPI { return c.ScheduledPayment }
+
+// CardAPI returns the mock of the CardAPI.
+func (c *Client) CardAPI() bunq.CardAPI { return c.Card }
+
+// ContentAPI returns the mock of the ContentAPI.
+func (c *Client) ContentAPI() bunq.ContentAPI { return c.Content }
+
+// RequestResponseAPI returns the mock of the RequestResponseAPI.
+func (c *Client) RequestResponseAPI() bunq.RequestResponseAPI { return c.RequestResponse }
+
+// RequestInquiryAPI returns the mock of the RequestInquiryAPI.
+func (c *Client) RequestInquiryAPI() bunq.RequestInquiryAPI { return c.RequestInquiry }
+
+// NotificationFilterAPI returns the mock of the NotificationFilterAPI.
+func (c *Client) NotificationFilterAPI() bunq.NotificationFilterAPI { return c.NotificationFilter }
+
+// OAuthAPI returns the mock of the OAuthAPI.
+func (c *Client) OAuthAPI() bunq.OAuthAPI { return c.OAuth }
+
+// BunqMeAPI returns the mock of the BunqMeAPI.
+func (c *Client) BunqMeAPI() bunq.BunqMeAPI { return c.BunqMe }
+
+// EventAPI returns the mock of the EventAPI.
+func (c *Client) EventAPI() bunq.EventAPI { return c.Event }
+
+// InvoiceAPI returns the mock of the InvoiceAPI.
+func (c *Client) InvoiceAPI() bunq.InvoiceAPI { return c.Invoice }
+
+// ShareInviteAPI returns the mock of the ShareInviteAPI.
+func (c *Client) ShareInviteAPI() bunq.ShareInviteAPI { return c.ShareInvite }
+
+// DirectDebitAPI returns the mock of the DirectDebitAPI.
+func (c *Client) DirectDebitAPI() bunq.DirectDebitAPI { return c.DirectDebit }
+
+// InternationalAPI returns the mock of the InternationalAPI.
+func (c *Client) InternationalAPI() bunq.InternationalAPI { return c.International }
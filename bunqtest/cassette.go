@@ -0,0 +1,372 @@
+package bunqtest
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// EnvRecord is the environment variable that makes OpenCassette record, even if the cassette exists.
+const EnvRecord = "BUNQTEST_RECORD"
+
+// redacted replaces secrets in cassettes.
+const redacted = "REDACTED"
+
+// scrubbedFields are the JSON fields and query parameters that are replaced with redacted in cassettes:
+// tokens, API keys, OAuth authorization codes and keys that change with every run.
+var scrubbedFields = map[string]bool{
+	"token":             true,
+	"secret":            true,
+	"api_key":           true,
+	"client_public_key": true,
+	"server_public_key": true,
+	"access_token":      true,
+	"client_secret":     true,
+	"code":              true,
+}
+
+// scrubbedHeaders are the response headers that are not recorded. The length changes as the body is re-encoded.
+var scrubbedHeaders = []string{headerServerSignature, headerAuthentication, "Set-Cookie", "Content-Length"}
+
+// Cassette is an http.RoundTripper that records the interactions of a bunq.Client with the bunq API
+// to a file, or replays them from it, so tests against the sandbox can run without network in CI:
+//
+//	cassette, err := bunqtest.OpenCassette("testdata/payments.json")
+//	c := bunq.NewClient(ctx, bunq.BaseURLSandbox, key, apiKey, "test", bunq.WildcardIP)
+//	c.Client = cassette.HTTPClient()
+//	...
+//	err = cassette.Save()
+//
+// Tokens, API keys and signatures are scrubbed before recording. A replayed request is matched by its
+// method, path and body to the first recorded interaction that was not replayed yet. As the real server
+// key is not known, the server public key of the installation is replaced with a test key, which signs
+// the replayed responses, so the client verifies them like the real ones.
+type Cassette struct {
+	path string
+	// next makes the requests of a recording cassette, it is nil when replaying.
+	next http.RoundTripper
+
+	// key signs the replayed responses, publicKeyPEM replaces the server public key.
+	key          *rsa.PrivateKey
+	publicKeyPEM string
+
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// Interaction is a recorded request with its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request of an interaction. Path includes the query.
+type RecordedRequest struct {
+	Method string       `json:"method"`
+	Path   string       `json:"path"`
+	Body   RecordedBody `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response of an interaction.
+type RecordedResponse struct {
+	Status int          `json:"status"`
+	Header http.Header  `json:"header,omitempty"`
+	Body   RecordedBody `json:"body,omitempty"`
+}
+
+// RecordedBody is a recorded body. Bodies that aren't UTF-8, like images, are recorded base64 encoded.
+type RecordedBody []byte
+
+// MarshalJSON encodes the body as a string, or with a "base64:" prefix if it isn't UTF-8.
+func (b RecordedBody) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) && !bytes.HasPrefix(b, []byte(base64Prefix)) {
+		return json.Marshal(string(b))
+	}
+
+	return json.Marshal(base64Prefix + base64.StdEncoding.EncodeToString(b))
+}
+
+// UnmarshalJSON decodes a body encoded by MarshalJSON.
+func (b *RecordedBody) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(s, base64Prefix) {
+		*b = RecordedBody(s)
+		return nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, base64Prefix))
+	if err != nil {
+		return errors.Wrap(err, "bunqtest: invalid base64 body")
+	}
+	*b = decoded
+
+	return nil
+}
+
+const base64Prefix = "base64:"
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Record returns a cassette that makes requests with the transport, or http.DefaultTransport if it is nil,
+// and records them. Save writes them to the file at path.
+func Record(path string, transport http.RoundTripper) *Cassette {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Cassette{path: path, next: transport}
+}
+
+// Replay returns a cassette that replays the interactions recorded in the file at path.
+func Replay(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunqtest: could not read cassette")
+	}
+
+	var f cassetteFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, errors.Wrapf(err, "bunqtest: could not decode cassette %s", path)
+	}
+
+	key, publicKeyPEM := newServerKey()
+
+	return &Cassette{
+		path:         path,
+		key:          key,
+		publicKeyPEM: publicKeyPEM,
+		interactions: f.Interactions,
+		replayed:     make([]bool, len(f.Interactions)),
+	}, nil
+}
+
+// OpenCassette replays the cassette at path if it exists. Otherwise, or if the environment variable
+// BUNQTEST_RECORD is true, it records a new one with http.DefaultTransport.
+func OpenCassette(path string) (*Cassette, error) {
+	record, _ := strconv.ParseBool(os.Getenv(EnvRecord))
+	if _, err := os.Stat(path); record || errors.Is(err, os.ErrNotExist) {
+		return Record(path, nil), nil
+	}
+
+	return Replay(path)
+}
+
+// Recording reports whether the cassette records, rather than replays.
+func (c *Cassette) Recording() bool {
+	return c.next != nil
+}
+
+// HTTPClient returns an HTTP client using the cassette, to be set as Client of a bunq.Client.
+func (c *Cassette) HTTPClient() *http.Client {
+	return &http.Client{Transport: c}
+}
+
+// Interactions returns the recorded interactions.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// Save writes the recorded interactions to the file of the cassette, creating its directory.
+// It does nothing when replaying.
+func (c *Cassette) Save() error {
+	if !c.Recording() {
+		return nil
+	}
+
+	c.mu.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return errors.Wrap(err, "bunqtest: could not encode cassette")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return errors.Wrap(err, "bunqtest: could not create cassette directory")
+	}
+
+	return errors.Wrap(os.WriteFile(c.path, append(data, '\n'), 0o644), "bunqtest: could not write cassette")
+}
+
+// RoundTrip records or replays the request.
+func (c *Cassette) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = io.ReadAll(r.Body)
+		_ = r.Body.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, "bunqtest: could not read request body")
+		}
+	}
+
+	req := RecordedRequest{Method: r.Method, Path: scrubPath(r.URL), Body: scrubBody(body)}
+
+	if c.Recording() {
+		return c.record(r, body, req)
+	}
+
+	return c.replay(r, req)
+}
+
+func (c *Cassette) record(r *http.Request, body []byte, req RecordedRequest) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	res, err := c.next.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "bunqtest: could not read response body")
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	header := res.Header.Clone()
+	for _, h := range scrubbedHeaders {
+		header.Del(h)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, Interaction{
+		Request:  req,
+		Response: RecordedResponse{Status: res.StatusCode, Header: header, Body: scrubBody(resBody)},
+	})
+
+	return res, nil
+}
+
+func (c *Cassette) replay(r *http.Request, req RecordedRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, in := range c.interactions {
+		if c.replayed[i] || in.Request.Method != req.Method || in.Request.Path != req.Path || !bytes.Equal(in.Request.Body, req.Body) {
+			continue
+		}
+		c.replayed[i] = true
+
+		// The client gets the test key instead of the scrubbed server public key on installation.
+		body := rewriteJSON(in.Response.Body, func(field string) (string, bool) {
+			return c.publicKeyPEM, field == "server_public_key"
+		})
+
+		header := in.Response.Header.Clone()
+		if header == nil {
+			header = make(http.Header)
+		}
+		header.Set(headerServerSignature, signBody(c.key, body))
+		if id := r.Header.Get(headerClientRequestID); id != "" {
+			header.Set(headerClientRequestID, id)
+		}
+
+		return &http.Response{
+			Status:        strconv.Itoa(in.Response.Status) + " " + http.StatusText(in.Response.Status),
+			StatusCode:    in.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       r,
+		}, nil
+	}
+
+	return nil, errors.Errorf("bunqtest: no recorded interaction for %s %s in cassette %s", req.Method, req.Path, c.path)
+}
+
+// scrubPath returns the path and query of the URL, with the scrubbed query parameters redacted.
+func scrubPath(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.Path
+	}
+
+	q := u.Query()
+	for k := range q {
+		if scrubbedFields[k] {
+			q.Set(k, redacted)
+		}
+	}
+
+	return u.Path + "?" + q.Encode()
+}
+
+// scrubBody redacts the scrubbed fields of a JSON body. Other bodies are returned as they are.
+func scrubBody(body []byte) RecordedBody {
+	return rewriteJSON(body, func(field string) (string, bool) {
+		return redacted, scrubbedFields[field]
+	})
+}
+
+// rewriteJSON replaces the string values of the fields of a JSON body, at any depth, for which replace
+// returns true. The body is re-encoded compactly. Bodies that aren't JSON are returned as they are.
+func rewriteJSON(body []byte, replace func(field string) (string, bool)) []byte {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return body
+	}
+
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil || d.More() {
+		return body
+	}
+
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(rewriteValue(v, replace)); err != nil {
+		return body
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+func rewriteValue(v interface{}, replace func(field string) (string, bool)) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if _, ok := value.(string); ok {
+				if s, ok := replace(k); ok {
+					v[k] = s
+					continue
+				}
+			}
+			v[k] = rewriteValue(value, replace)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = rewriteValue(value, replace)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,140 @@
+package bunqtest
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCassetteClient(t *testing.T, baseURL, apiKey string, cassette *Cassette) *bunq.Client {
+	t.Helper()
+
+	key, err := bunq.CreateNewKeyPair()
+	require.NoError(t, err)
+
+	c := bunq.NewClient(context.Background(), baseURL, key, apiKey, deviceDescription, bunq.WildcardIP)
+	c.Client = cassette.HTTPClient()
+	require.NoError(t, c.Init())
+
+	return c
+}
+
+func TestCassette(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	userID, apiKey := srv.AddUserPerson(model.UserPerson{FirstName: "Ada"})
+	accountID := srv.AddMonetaryAccountBank(userID, model.MonetaryAccountBank{Balance: model.MustParseAmount("100.00 EUR")})
+
+	counterparty, err := model.IBANPointer("NL02ABNA0123456789", "Grace Hopper")
+	require.NoError(t, err)
+	payment := model.PaymentCreate{Amount: model.MustParseAmount("12.50 EUR"), CounterpartyAlias: counterparty, Description: "Lunch"}
+
+	// The same requests are made while recording and replaying.
+	run := func(c *bunq.Client) (int, int) {
+		u, err := c.UserService.GetUser()
+		require.NoError(t, err)
+
+		res, err := c.PaymentService.CreatePayment(accountID, payment)
+		require.NoError(t, err)
+
+		require.NoError(t, c.Close())
+
+		return u.UserID(), res.ID()
+	}
+
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	recorder := Record(path, srv.Client().Transport)
+	assert.True(t, recorder.Recording())
+	recordedUserID, recordedPaymentID := run(newCassetteClient(t, srv.BaseURL, apiKey, recorder))
+	require.NoError(t, recorder.Save())
+	assert.Len(t, recorder.Interactions(), 6)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), apiKey)
+	assert.NotContains(t, string(data), srv.publicKeyPEM[30:60])
+	assert.NotContains(t, string(data), headerServerSignature)
+	srv.mu.Lock()
+	for token := range srv.installations {
+		assert.NotContains(t, string(data), token)
+	}
+	srv.mu.Unlock()
+
+	// Replaying needs neither the server nor the API key, the host doesn't matter either.
+	replayer, err := Replay(path)
+	require.NoError(t, err)
+	assert.False(t, replayer.Recording())
+	c := newCassetteClient(t, "https://bunq.invalid/v1/", "sandbox_"+redacted, replayer)
+
+	u, err := c.UserService.GetUser()
+	require.NoError(t, err)
+	assert.Equal(t, recordedUserID, u.UserID())
+	assert.Equal(t, "Ada", u.UserDisplayName())
+
+	_, err = c.PaymentService.CreatePayment(accountID, model.PaymentCreate{Amount: model.MustParseAmount("1.00 EUR"), CounterpartyAlias: counterparty})
+	assert.ErrorContains(t, err, "no recorded interaction for POST")
+
+	res, err := c.PaymentService.CreatePayment(accountID, payment)
+	require.NoError(t, err)
+	assert.Equal(t, recordedPaymentID, res.ID())
+
+	require.NoError(t, c.Close())
+	assert.Len(t, srv.Payments(accountID), 1)
+
+	// The interaction was replayed already.
+	_, err = c.PaymentService.CreatePayment(accountID, payment)
+	assert.Error(t, err)
+}
+
+func TestOpenCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	c, err := OpenCassette(path)
+	require.NoError(t, err)
+	assert.True(t, c.Recording())
+	require.NoError(t, c.Save())
+
+	c, err = OpenCassette(path)
+	require.NoError(t, err)
+	assert.False(t, c.Recording())
+
+	t.Setenv(EnvRecord, "true")
+	c, err = OpenCassette(path)
+	require.NoError(t, err)
+	assert.True(t, c.Recording())
+}
+
+func TestScrub(t *testing.T) {
+	t.Parallel()
+
+	body := scrubBody([]byte(`{"Response":[{"Token":{"id":1,"token":"abc"}},{"amount":{"value":"1.10"}}],"secret":"key","n":12345678901234567890}`))
+	assert.JSONEq(t, `{"Response":[{"Token":{"id":1,"token":"REDACTED"}},{"amount":{"value":"1.10"}}],"secret":"REDACTED","n":12345678901234567890}`, string(body))
+
+	// The API key of a user created with the sandbox.
+	body = scrubBody([]byte(`{"Response":[{"ApiKey":{"api_key":"sandbox_5c1b8e8e43e34f3c9d0b4b3a6f6e2a10","user":{"UserPerson":{"id":6204}}}}]}`))
+	assert.JSONEq(t, `{"Response":[{"ApiKey":{"api_key":"REDACTED","user":{"UserPerson":{"id":6204}}}}]}`, string(body))
+
+	assert.Equal(t, RecordedBody("not json"), scrubBody([]byte("not json")))
+
+	u, err := url.Parse("https://api.oauth.bunq.com/v1/token?grant_type=authorization_code&code=abc123&client_secret=secret")
+	require.NoError(t, err)
+	assert.Equal(t, "/v1/token?client_secret=REDACTED&code=REDACTED&grant_type=authorization_code", scrubPath(u))
+
+	for _, b := range []RecordedBody{RecordedBody("text"), {0xff, 0xd8, 0xff}, RecordedBody("base64:text")} {
+		data, err := b.MarshalJSON()
+		require.NoError(t, err)
+
+		var decoded RecordedBody
+		require.NoError(t, decoded.UnmarshalJSON(data))
+		assert.Equal(t, b, decoded)
+	}
+}
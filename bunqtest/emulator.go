@@ -0,0 +1,281 @@
+package bunqtest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+// Recurrence units of scheduled payments.
+const (
+	RecurrenceOnce    = "ONCE"
+	RecurrenceHourly  = "HOURLY"
+	RecurrenceDaily   = "DAILY"
+	RecurrenceWeekly  = "WEEKLY"
+	RecurrenceMonthly = "MONTHLY"
+	RecurrenceYearly  = "YEARLY"
+)
+
+// schedule is a scheduled payment, which is made when the clock of the emulator passes its next time.
+type schedule struct {
+	scheduled *model.ScheduledPayment
+	payment   payment
+	// runs is the number of times the payment was due.
+	runs int
+}
+
+// draft is a draft payment, which is made when it is accepted.
+type draft struct {
+	draft   model.DraftPayment
+	entries []model.DraftPaymentEntryCreate
+}
+
+// NewEmulator starts a fake bunq API like NewServer, which moves money: payments are booked in a ledger,
+// change the balances of the monetary accounts and fail if the balance is insufficient. Payments
+// between accounts of the emulator appear on both accounts. Its clock starts at start and only
+// changes with Advance and AdvanceTo, which make the scheduled payments that are due.
+//
+// Requests for money to the sandbox sugar daddy are paid, like in the sandbox of bunq.
+func NewEmulator(start time.Time) *Server {
+	st := newStore()
+	st.emulated = true
+	st.clock = start.UTC()
+
+	return newServer(st)
+}
+
+// Now returns the time of the server, which is the clock of an emulator or the real time.
+func (s *Server) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store.now().Time
+}
+
+// Advance advances the clock of the emulator by d, see AdvanceTo.
+func (s *Server) Advance(d time.Duration) {
+	s.AdvanceTo(s.Now().Add(d))
+}
+
+// AdvanceTo sets the clock of the emulator to t, making the scheduled payments that are due
+// until then in order, each at its time. Scheduled payments that fail for an insufficient
+// balance are skipped. It panics if the server is not an emulator or t is before its time.
+func (s *Server) AdvanceTo(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.store.emulated {
+		panic("bunqtest: the clock can only be advanced of an emulator")
+	}
+	if t.Before(s.store.clock) {
+		panic(fmt.Sprintf("bunqtest: can't set the clock back from %s to %s", s.store.clock, t))
+	}
+
+	for {
+		var due *schedule
+		var at time.Time
+		for _, sc := range s.store.schedules {
+			if next, ok := sc.next(); ok && !next.After(t) && (due == nil || next.Before(at)) {
+				due, at = sc, next
+			}
+		}
+		if due == nil {
+			break
+		}
+
+		s.store.clock = at
+		s.store.run(due)
+	}
+
+	s.store.clock = t.UTC()
+}
+
+// SchedulePayment schedules the payment from the monetary account and returns the id of the scheduled payment.
+// The schedule needs a start time and a recurrence unit like RecurrenceMonthly; a recurrence size of
+// zero is one. It panics if the account does not exist or the schedule is invalid.
+func (s *Server) SchedulePayment(monetaryAccountID int, p model.PaymentCreate, sched model.Schedule) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.store.accounts[monetaryAccountID]
+	if !ok {
+		panic(fmt.Sprintf("bunqtest: monetary account %d does not exist", monetaryAccountID))
+	}
+	if sched.TimeStart.IsZero() {
+		panic("bunqtest: the schedule has no start time")
+	}
+	if sched.RecurrenceSize == 0 {
+		sched.RecurrenceSize = 1
+	}
+	switch sched.RecurrenceUnit {
+	case RecurrenceOnce, RecurrenceHourly, RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly, RecurrenceYearly:
+	default:
+		panic(fmt.Sprintf("bunqtest: invalid recurrence unit %q", sched.RecurrenceUnit))
+	}
+	sched.Status = "ACTIVE"
+
+	t := s.store.now()
+	sp := &model.ScheduledPayment{
+		Common:            model.Common{ID: s.store.newID(), Created: t, Updated: t},
+		MonetaryAccountID: monetaryAccountID,
+		Payment: model.ScheduledPaymentEntry{
+			Amount:            parsedAmount(p.Amount),
+			Alias:             s.store.label(acc),
+			CounterpartyAlias: pointerLabel(p.CounterpartyAlias),
+			Description:       p.Description,
+			AllowBunqTo:       p.AllowBunqto,
+		},
+		Schedule: sched,
+		Status:   "ACTIVE",
+	}
+
+	s.store.schedules = append(s.store.schedules, &schedule{
+		scheduled: sp,
+		payment: payment{
+			amount:       p.Amount,
+			counterparty: p.CounterpartyAlias,
+			description:  p.Description,
+			scheduledID:  sp.ID,
+		},
+	})
+
+	return sp.ID
+}
+
+// AcceptDraftPayment accepts the draft payment of the monetary account, like its user does in the app,
+// which makes its payments at once.
+func (s *Server) AcceptDraftPayment(monetaryAccountID, draftPaymentID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, acc, err := s.store.pendingDraft(monetaryAccountID, draftPaymentID)
+	if err != nil {
+		return err
+	}
+
+	payments := make([]payment, 0, len(d.entries))
+	batchID := 0
+	if len(d.entries) > 1 {
+		batchID = s.store.newID()
+	}
+	for _, e := range d.entries {
+		payments = append(payments, payment{
+			amount:       e.Amount,
+			counterparty: e.CounterpartyAlias,
+			description:  e.Description,
+			batchID:      batchID,
+		})
+	}
+
+	if _, err := s.store.pay(acc, payments); err != nil {
+		return errors.Wrapf(err, "bunqtest: could not accept draft payment %d", draftPaymentID)
+	}
+
+	d.draft.Status = "ACCEPTED"
+	d.draft.Updated = s.store.now()
+
+	return nil
+}
+
+// RejectDraftPayment rejects the draft payment of the monetary account, like its user does in the app.
+func (s *Server) RejectDraftPayment(monetaryAccountID, draftPaymentID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, _, err := s.store.pendingDraft(monetaryAccountID, draftPaymentID)
+	if err != nil {
+		return err
+	}
+
+	d.draft.Status = "REJECTED"
+	d.draft.Updated = s.store.now()
+
+	return nil
+}
+
+// DraftPayments returns the draft payments of the monetary account, oldest first.
+func (s *Server) DraftPayments(monetaryAccountID int) []model.DraftPayment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.store.accounts[monetaryAccountID]
+	if !ok {
+		return nil
+	}
+
+	drafts := make([]model.DraftPayment, 0, len(acc.drafts))
+	for _, d := range acc.drafts {
+		drafts = append(drafts, d.draft)
+	}
+
+	return drafts
+}
+
+func (st *store) pendingDraft(monetaryAccountID, draftPaymentID int) (*draft, *account, error) {
+	acc, ok := st.accounts[monetaryAccountID]
+	if !ok {
+		return nil, nil, errors.Errorf("bunqtest: monetary account %d does not exist", monetaryAccountID)
+	}
+
+	for _, d := range acc.drafts {
+		if d.draft.ID != draftPaymentID {
+			continue
+		}
+		if d.draft.Status != "PENDING" {
+			return nil, nil, errors.Errorf("bunqtest: draft payment %d is %s", draftPaymentID, d.draft.Status)
+		}
+
+		return d, acc, nil
+	}
+
+	return nil, nil, errors.Errorf("bunqtest: draft payment %d does not exist", draftPaymentID)
+}
+
+// run makes the scheduled payment that is due.
+func (st *store) run(sc *schedule) {
+	sc.runs++
+
+	if acc, ok := st.accounts[sc.scheduled.MonetaryAccountID]; ok {
+		_, _ = st.pay(acc, []payment{sc.payment})
+	}
+
+	if _, ok := sc.next(); !ok {
+		sc.scheduled.Status = "FINISHED"
+		sc.scheduled.Schedule.Status = "FINISHED"
+	}
+	sc.scheduled.Updated = st.now()
+}
+
+// next returns the next time the scheduled payment is due, and false if it is finished.
+func (sc *schedule) next() (time.Time, bool) {
+	sched := sc.scheduled.Schedule
+	start := sched.TimeStart.Time
+	n := sc.runs * sched.RecurrenceSize
+
+	var next time.Time
+	switch sched.RecurrenceUnit {
+	case RecurrenceOnce:
+		if sc.runs > 0 {
+			return time.Time{}, false
+		}
+		next = start
+	case RecurrenceHourly:
+		next = start.Add(time.Duration(n) * time.Hour)
+	case RecurrenceDaily:
+		next = start.AddDate(0, 0, n)
+	case RecurrenceWeekly:
+		next = start.AddDate(0, 0, 7*n)
+	case RecurrenceMonthly:
+		next = start.AddDate(0, n, 0)
+	case RecurrenceYearly:
+		next = start.AddDate(n, 0, 0)
+	}
+
+	if !sched.TimeEnd.IsZero() && next.After(sched.TimeEnd.Time) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
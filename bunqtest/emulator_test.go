@@ -0,0 +1,245 @@
+package bunqtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var emulatorStart = time.Date(2024, time.January, 15, 9, 0, 0, 0, time.UTC)
+
+func newTestEmulator(t *testing.T) *Server {
+	srv := NewEmulator(emulatorStart)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func balance(t *testing.T, srv *Server, accountID int) string {
+	acc, ok := srv.MonetaryAccountBank(accountID)
+	require.True(t, ok)
+
+	return acc.Balance.String()
+}
+
+func TestEmulatorPayments(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestEmulator(t)
+
+	aliceID, apiKey := srv.AddUserPerson(model.UserPerson{FirstName: "Alice"})
+	alice := srv.AddMonetaryAccountBank(aliceID, model.MonetaryAccountBank{Balance: model.MustParseAmount("100.00 EUR")})
+	bobID, _ := srv.AddUserPerson(model.UserPerson{FirstName: "Bob", BaseUser: model.BaseUser{
+		Alias: []model.Alias{{Type: model.PointerTypeEmail, Value: "bob@example.com"}},
+	}})
+	bob := srv.AddMonetaryAccountBank(bobID, model.MonetaryAccountBank{})
+
+	c := newTestClient(t, srv, apiKey)
+
+	toBob, err := model.EmailPointer("Bob@Example.com")
+	require.NoError(t, err)
+	_, err = c.PaymentService.CreatePayment(alice, model.PaymentCreate{
+		Amount:            model.MustParseAmount("30.00 EUR"),
+		CounterpartyAlias: toBob,
+		Description:       "Dinner",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "70.00 EUR", balance(t, srv, alice))
+	assert.Equal(t, "30.00 EUR", balance(t, srv, bob))
+
+	incoming := srv.Payments(bob)
+	require.Len(t, incoming, 1)
+	assert.Equal(t, "30.00 EUR", incoming[0].Amount.String())
+	assert.Equal(t, "30.00 EUR", incoming[0].BalanceAfterMutation.String())
+	assert.Equal(t, "Alice", incoming[0].CounterpartyAlias.DisplayName)
+	assert.True(t, incoming[0].Created.Equal(emulatorStart))
+
+	external, err := model.IBANPointer("NL02ABNA0123456789", "Grace Hopper")
+	require.NoError(t, err)
+	_, err = c.PaymentService.CreatePaymentBatch(alice, model.PaymentBatchCreate{Payments: []model.PaymentCreate{
+		{Amount: model.MustParseAmount("10.00 EUR"), CounterpartyAlias: external, Description: "Book"},
+		{Amount: model.MustParseAmount("5.00 EUR"), CounterpartyAlias: toBob, Description: "Coffee"},
+	}})
+	require.NoError(t, err)
+
+	outgoing := srv.Payments(alice)
+	require.Len(t, outgoing, 3)
+	assert.Equal(t, "EBA_SCT", outgoing[1].Type)
+	assert.Equal(t, "60.00 EUR", outgoing[1].BalanceAfterMutation.String())
+	assert.Equal(t, "55.00 EUR", outgoing[2].BalanceAfterMutation.String())
+	assert.NotZero(t, outgoing[1].BatchID)
+	assert.Equal(t, outgoing[1].BatchID, outgoing[2].BatchID)
+	assert.Equal(t, "35.00 EUR", balance(t, srv, bob))
+
+	_, err = c.PaymentService.CreatePayment(alice, model.PaymentCreate{
+		Amount:            model.MustParseAmount("55.01 EUR"),
+		CounterpartyAlias: external,
+	})
+	assert.ErrorContains(t, err, "Insufficient balance.")
+	assert.Equal(t, "55.00 EUR", balance(t, srv, alice))
+
+	// Opening balance, payment, and batch.
+	assert.Len(t, srv.Ledger(), 3)
+	assert.NoError(t, srv.VerifyLedger())
+}
+
+func TestEmulatorScheduledPayments(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestEmulator(t)
+
+	userID, apiKey := srv.AddUserPerson(model.UserPerson{})
+	accountID := srv.AddMonetaryAccountBank(userID, model.MonetaryAccountBank{Balance: model.MustParseAmount("2000.00 EUR")})
+
+	landlord, err := model.IBANPointer("NL02ABNA0123456789", "Landlord")
+	require.NoError(t, err)
+	rentID := srv.SchedulePayment(accountID, model.PaymentCreate{
+		Amount:            model.MustParseAmount("600.00 EUR"),
+		CounterpartyAlias: landlord,
+		Description:       "Rent",
+	}, model.Schedule{
+		TimeStart:      model.NewTime(time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		RecurrenceUnit: RecurrenceMonthly,
+	})
+
+	srv.Advance(24 * time.Hour)
+	assert.Empty(t, srv.Payments(accountID))
+
+	srv.AdvanceTo(time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC))
+	payments := srv.Payments(accountID)
+	require.Len(t, payments, 3)
+	for i, p := range payments {
+		assert.Equal(t, time.Month(i+2), p.Created.Month())
+		assert.Equal(t, 1, p.Created.Day())
+		assert.Equal(t, rentID, p.ScheduledID)
+	}
+	assert.Equal(t, "200.00 EUR", balance(t, srv, accountID))
+	assert.Equal(t, time.Date(2024, time.April, 15, 0, 0, 0, 0, time.UTC), srv.Now())
+
+	// The rent of May can't be paid and is skipped, the salary of May arrives too late.
+	srv.AdvanceTo(time.Date(2024, time.May, 25, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, srv.Deposit(accountID, model.MustParseAmount("3000.00 EUR"), "Salary"))
+	srv.AdvanceTo(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "2600.00 EUR", balance(t, srv, accountID))
+	assert.Len(t, srv.Payments(accountID), 5)
+	assert.NoError(t, srv.VerifyLedger())
+
+	c := newTestClient(t, srv, apiKey)
+	res, err := c.ScheduledPaymentService.GetAllScheduledPayments(accountID)
+	require.NoError(t, err)
+	require.Len(t, res.Response, 1)
+	assert.Equal(t, "ACTIVE", res.Response[0].ScheduledPayment.Status)
+	assert.Equal(t, "600.00 EUR", res.Response[0].ScheduledPayment.Payment.Amount.String())
+}
+
+func TestEmulatorScheduledPaymentEnds(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestEmulator(t)
+
+	userID, _ := srv.AddUserPerson(model.UserPerson{})
+	accountID := srv.AddMonetaryAccountBank(userID, model.MonetaryAccountBank{Balance: model.MustParseAmount("100.00 EUR")})
+	savingsID := srv.AddMonetaryAccountBank(userID, model.MonetaryAccountBank{})
+	savings, _ := srv.MonetaryAccountBank(savingsID)
+
+	to, err := model.IBANPointer(savings.GetIBANPointer().Value, "Savings")
+	require.NoError(t, err)
+	srv.SchedulePayment(accountID, model.PaymentCreate{Amount: model.MustParseAmount("10.00 EUR"), CounterpartyAlias: to}, model.Schedule{
+		TimeStart:      model.NewTime(emulatorStart),
+		TimeEnd:        model.NewTime(emulatorStart.AddDate(0, 0, 14)),
+		RecurrenceUnit: RecurrenceWeekly,
+	})
+	srv.SchedulePayment(accountID, model.PaymentCreate{Amount: model.MustParseAmount("1.00 EUR"), CounterpartyAlias: to}, model.Schedule{
+		TimeStart:      model.NewTime(emulatorStart.Add(time.Hour)),
+		RecurrenceUnit: RecurrenceOnce,
+	})
+
+	srv.Advance(365 * 24 * time.Hour)
+	assert.Equal(t, "69.00 EUR", balance(t, srv, accountID))
+	assert.Equal(t, "31.00 EUR", balance(t, srv, savingsID))
+	assert.NoError(t, srv.VerifyLedger())
+
+	assert.Panics(t, func() { srv.AdvanceTo(emulatorStart) })
+	assert.Panics(t, func() { newTestServer(t).Advance(time.Hour) })
+}
+
+func TestEmulatorDraftPayments(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestEmulator(t)
+
+	userID, apiKey := srv.AddUserPerson(model.UserPerson{})
+	accountID := srv.AddMonetaryAccountBank(userID, model.MonetaryAccountBank{Balance: model.MustParseAmount("100.00 EUR")})
+
+	c := newTestClient(t, srv, apiKey)
+
+	to, err := model.IBANPointer("NL02ABNA0123456789", "Grace Hopper")
+	require.NoError(t, err)
+	res, err := c.PaymentService.CreateDraftPayment(accountID, model.RequestCreateDraftPayment{Entries: []model.DraftPaymentEntryCreate{
+		{Amount: model.MustParseAmount("20.00 EUR"), CounterpartyAlias: to, Description: "First"},
+		{Amount: model.MustParseAmount("30.00 EUR"), CounterpartyAlias: to, Description: "Second"},
+	}})
+	require.NoError(t, err)
+	draftID := res.ID()
+
+	assert.Equal(t, "100.00 EUR", balance(t, srv, accountID))
+	drafts := srv.DraftPayments(accountID)
+	require.Len(t, drafts, 1)
+	assert.Equal(t, "PENDING", drafts[0].Status)
+
+	require.NoError(t, srv.AcceptDraftPayment(accountID, draftID))
+	assert.Equal(t, "50.00 EUR", balance(t, srv, accountID))
+
+	payments := srv.Payments(accountID)
+	require.Len(t, payments, 2)
+	assert.NotZero(t, payments[0].BatchID)
+
+	draft, err := c.PaymentService.GetDraftPayment(draftID, accountID)
+	require.NoError(t, err)
+	require.Len(t, draft.Response, 1)
+	assert.Equal(t, "ACCEPTED", draft.Response[0].DraftPayment.Status)
+
+	assert.Error(t, srv.AcceptDraftPayment(accountID, draftID))
+	assert.Error(t, srv.RejectDraftPayment(accountID, draftID))
+
+	// Drafts over the balance can't be accepted.
+	res, err = c.PaymentService.CreateDraftPayment(accountID, model.RequestCreateDraftPayment{Entries: []model.DraftPaymentEntryCreate{
+		{Amount: model.MustParseAmount("50.01 EUR"), CounterpartyAlias: to},
+	}})
+	require.NoError(t, err)
+	assert.ErrorContains(t, srv.AcceptDraftPayment(accountID, res.ID()), "Insufficient balance.")
+	require.NoError(t, srv.RejectDraftPayment(accountID, res.ID()))
+	assert.Equal(t, "50.00 EUR", balance(t, srv, accountID))
+}
+
+func TestEmulatorSugarDaddy(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestEmulator(t)
+
+	userID, apiKey := srv.AddUserPerson(model.UserPerson{})
+	accountID := srv.AddMonetaryAccountBank(userID, model.MonetaryAccountBank{})
+
+	c := newTestClient(t, srv, apiKey)
+
+	sugarDaddy, err := model.EmailPointer(sugarDaddyEmail)
+	require.NoError(t, err)
+	for _, amount := range []string{"500.00 EUR", "500.01 EUR"} {
+		_, err = c.RequestInquiryService.CreateRequestInquiry(accountID, model.RequestInquiryCreate{
+			AmountInquired:    model.MustParseAmount(amount),
+			CounterpartyAlias: sugarDaddy,
+		})
+		require.NoError(t, err)
+	}
+
+	requests := srv.RequestInquiries(accountID)
+	require.Len(t, requests, 2)
+	assert.Equal(t, "ACCEPTED", requests[0].Status)
+	assert.Equal(t, "PENDING", requests[1].Status)
+	assert.Equal(t, "500.00 EUR", balance(t, srv, accountID))
+	assert.NoError(t, srv.VerifyLedger())
+}
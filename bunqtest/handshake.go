@@ -0,0 +1,171 @@
+package bunqtest
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"strconv"
+
+	"github.com/d0x7/go-bunq/model"
+)
+
+const (
+	errDescriptionAuth        = "Insufficient authorisation."
+	errDescriptionSignature   = "The request signature is invalid."
+	errDescriptionCredentials = "User credentials are incorrect. Incorrect API key or IP address."
+)
+
+// handleInstallation registers the public key of a client and returns the installation token and server key.
+func (s *Server) handleInstallation(w http.ResponseWriter, r *http.Request) {
+	var body model.RequestInstallation
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body.")
+		return
+	}
+
+	block, _ := pem.Decode([]byte(body.ClientPublicKey))
+	if block == nil {
+		writeError(w, http.StatusBadRequest, "Invalid client public key.")
+		return
+	}
+	pubKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid client public key.")
+		return
+	}
+	clientKey, ok := pubKey.(*rsa.PublicKey)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "The client public key must be an RSA key.")
+		return
+	}
+
+	inst := &installation{
+		id:        s.store.newID(),
+		token:     newToken(),
+		clientKey: clientKey,
+		secrets:   make(map[string]bool),
+	}
+	s.installations[inst.token] = inst
+
+	writeJSON(w, http.StatusOK, map[string][]interface{}{"Response": {
+		map[string]model.BunqID{"Id": {ID: inst.id}},
+		map[string]model.Token{"Token": s.store.newToken(s.store.newID(), inst.token)},
+		map[string]model.ServerPublicKey{"ServerPublicKey": {ServerPublicKey: s.publicKeyPEM}},
+	}})
+}
+
+// handleDeviceServer registers the API key as device of the installation.
+func (s *Server) handleDeviceServer(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.authenticateInstallation(w, r)
+	if !ok {
+		return
+	}
+
+	var body model.RequestDeviceServer
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body.")
+		return
+	}
+	if _, ok := s.store.apiKeys[body.Secret]; !ok {
+		writeError(w, http.StatusBadRequest, errDescriptionCredentials)
+		return
+	}
+
+	inst.secrets[body.Secret] = true
+
+	writeJSON(w, http.StatusOK, model.ResponseDeviceServer{Response: []model.WrappedBunqID{
+		{ID: model.BunqID{ID: s.store.newID()}},
+	}})
+}
+
+// handleSessionServer opens a session for the user of the API key, which must be a device of the installation.
+func (s *Server) handleSessionServer(w http.ResponseWriter, r *http.Request) {
+	inst, ok := s.authenticateInstallation(w, r)
+	if !ok {
+		return
+	}
+
+	var body model.RequestSessionServer
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body.")
+		return
+	}
+	userID, ok := s.store.apiKeys[body.Secret]
+	if !ok || !inst.secrets[body.Secret] {
+		writeError(w, http.StatusBadRequest, errDescriptionCredentials)
+		return
+	}
+
+	sess := &session{
+		id:           s.store.newID(),
+		token:        newToken(),
+		installation: inst,
+		userID:       userID,
+	}
+	s.sessions[sess.token] = sess
+
+	u := s.store.users[userID]
+	writeJSON(w, http.StatusOK, map[string][]interface{}{"Response": {
+		map[string]model.BunqID{"Id": {ID: sess.id}},
+		map[string]model.Token{"Token": s.store.newToken(sess.id, sess.token)},
+		u.wrapped(),
+	}})
+}
+
+// handleSessionDelete ends the session of the request.
+func (s *Server) handleSessionDelete(w http.ResponseWriter, r *http.Request, sess *session) {
+	if r.PathValue("sessionID") != strconv.Itoa(sess.id) {
+		writeError(w, http.StatusNotFound, "Session not found.")
+		return
+	}
+
+	delete(s.sessions, sess.token)
+
+	writeJSON(w, http.StatusOK, map[string][]interface{}{"Response": {}})
+}
+
+// authenticateInstallation checks the installation token and signature of the request,
+// and writes the error if they are invalid.
+func (s *Server) authenticateInstallation(w http.ResponseWriter, r *http.Request) (*installation, bool) {
+	inst, ok := s.installations[r.Header.Get(headerAuthentication)]
+	if !ok {
+		writeError(w, http.StatusUnauthorized, errDescriptionAuth)
+		return nil, false
+	}
+	if !verifySignature(inst.clientKey, r) {
+		writeError(w, http.StatusBadRequest, errDescriptionSignature)
+		return nil, false
+	}
+
+	return inst, true
+}
+
+// authenticated wraps a handler that requires a session. The session token and signature of the request
+// are checked, and the user in the path must be the user of the session.
+func (s *Server) authenticated(h func(http.ResponseWriter, *http.Request, *session)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sess, ok := s.sessions[r.Header.Get(headerAuthentication)]
+		if !ok {
+			writeError(w, http.StatusUnauthorized, errDescriptionAuth)
+			return
+		}
+		if !verifySignature(sess.installation.clientKey, r) {
+			writeError(w, http.StatusBadRequest, errDescriptionSignature)
+			return
+		}
+		if userID := r.PathValue("userID"); userID != "" && userID != strconv.Itoa(sess.userID) {
+			writeError(w, http.StatusForbidden, errDescriptionAuth)
+			return
+		}
+
+		h(w, r, sess)
+	}
+}
+
+// newToken returns the token as model.Token, created now.
+func (st *store) newToken(id int, token string) model.Token {
+	t := st.now()
+
+	return model.Token{Common: model.Common{ID: id, Created: t, Updated: t}, Token: token}
+}
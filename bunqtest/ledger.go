@@ -0,0 +1,324 @@
+package bunqtest
+
+import (
+	"strings"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// sugarDaddyEmail is the email address of the sandbox user that accepts all requests for money,
+// like sandbox.SugarDaddyEmail.
+const sugarDaddyEmail = "sugardaddy@bunq.com"
+
+// maxSugarDaddyAmount is the largest amount the sugar daddy pays per request.
+var maxSugarDaddyAmount = decimal.NewFromInt(500)
+
+var errInsufficientBalance = errors.New("Insufficient balance.")
+
+// Transaction is a transfer of money in the ledger of an emulator. The amounts of its entries add up to zero.
+type Transaction struct {
+	ID          int
+	Time        time.Time
+	Description string
+	Entries     []Entry
+}
+
+// Entry is the change of the balance of a monetary account in a transaction. Money entering
+// or leaving the emulator is booked on the outside, an entry with MonetaryAccountID zero.
+type Entry struct {
+	MonetaryAccountID int
+	Amount            model.Amount
+}
+
+// payment is a payment to be made from a monetary account.
+type payment struct {
+	amount       model.Amount
+	counterparty model.Pointer
+	description  string
+	// batchID and scheduledID are the ids of the batch or the scheduled payment the payment belongs to.
+	batchID     int
+	scheduledID int
+}
+
+// pay makes the payments from the account at once and returns the outgoing payments.
+// An emulated store books them in the ledger, and accounts of the store that receive the money
+// get the incoming payments. Otherwise only the outgoing payments are added.
+func (st *store) pay(acc *account, payments []payment) ([]model.Payment, error) {
+	targets := make([]*account, len(payments))
+	running := map[int]decimal.Decimal{acc.bank.ID: amountDecimal(acc.bank.Balance)}
+
+	if st.emulated {
+		var entries []Entry
+		for i, p := range payments {
+			amount := parsedAmount(p.amount)
+
+			targetID := 0
+			if target := st.resolve(p.counterparty, amount.Currency); target != nil && target != acc {
+				targets[i] = target
+				targetID = target.bank.ID
+				running[targetID] = amountDecimal(target.bank.Balance)
+			}
+
+			entries = append(entries, Entry{MonetaryAccountID: acc.bank.ID, Amount: amount.Neg()}, Entry{MonetaryAccountID: targetID, Amount: amount})
+		}
+
+		if _, err := st.book(payments[0].description, entries...); err != nil {
+			return nil, err
+		}
+	}
+
+	t := st.now()
+	outgoing := make([]model.Payment, 0, len(payments))
+	for i, p := range payments {
+		amount := parsedAmount(p.amount)
+
+		out := model.Payment{
+			Common:            model.Common{ID: st.newID(), Created: t, Updated: t},
+			MonetaryAccountID: acc.bank.ID,
+			Amount:            amount.Neg(),
+			Alias:             st.label(acc),
+			CounterpartyAlias: pointerLabel(p.counterparty),
+			Description:       p.description,
+			Type:              "BUNQ",
+			SubType:           "PAYMENT",
+			BatchID:           p.batchID,
+			ScheduledID:       p.scheduledID,
+		}
+
+		target := targets[i]
+		if target != nil {
+			out.CounterpartyAlias = st.label(target)
+		} else if st.emulated && p.counterparty.PType == model.PointerTypeIBAN {
+			out.Type = "EBA_SCT"
+		}
+
+		if st.emulated {
+			running[acc.bank.ID] = running[acc.bank.ID].Sub(amountDecimal(amount))
+			out.BalanceAfterMutation = model.MustNewAmount(running[acc.bank.ID], amount.Currency)
+		}
+		acc.addPayment(out)
+		outgoing = append(outgoing, out)
+
+		if target != nil {
+			running[target.bank.ID] = running[target.bank.ID].Add(amountDecimal(amount))
+			target.addPayment(model.Payment{
+				Common:               model.Common{ID: st.newID(), Created: t, Updated: t},
+				MonetaryAccountID:    target.bank.ID,
+				Amount:               amount,
+				Alias:                st.label(target),
+				CounterpartyAlias:    st.label(acc),
+				Description:          p.description,
+				Type:                 "BUNQ",
+				SubType:              "PAYMENT",
+				BalanceAfterMutation: model.MustNewAmount(running[target.bank.ID], amount.Currency),
+			})
+		}
+	}
+
+	return outgoing, nil
+}
+
+// deposit books money from outside of the emulator on the account, with the incoming payment.
+func (st *store) deposit(acc *account, amount model.Amount, counterparty model.LabelMonetaryAccount, description, subType string) (model.Payment, error) {
+	amount = parsedAmount(amount)
+
+	if _, err := st.book(description, Entry{Amount: amount.Neg()}, Entry{MonetaryAccountID: acc.bank.ID, Amount: amount}); err != nil {
+		return model.Payment{}, err
+	}
+
+	t := st.now()
+	p := model.Payment{
+		Common:               model.Common{ID: st.newID(), Created: t, Updated: t},
+		MonetaryAccountID:    acc.bank.ID,
+		Amount:               amount,
+		Alias:                st.label(acc),
+		CounterpartyAlias:    counterparty,
+		Description:          description,
+		Type:                 "BUNQ",
+		SubType:              subType,
+		BalanceAfterMutation: acc.bank.Balance,
+	}
+	acc.addPayment(p)
+
+	return p, nil
+}
+
+// book adds a transaction to the ledger and changes the balances of the accounts. It fails without changes
+// if the balance of an account that decreases would drop below its overdraft limit.
+func (st *store) book(description string, entries ...Entry) (Transaction, error) {
+	sums := make(map[string]decimal.Decimal)
+	balances := make(map[int]decimal.Decimal)
+	for _, e := range entries {
+		sums[e.Amount.Currency] = sums[e.Amount.Currency].Add(amountDecimal(e.Amount))
+		if e.MonetaryAccountID == 0 {
+			continue
+		}
+
+		acc, ok := st.accounts[e.MonetaryAccountID]
+		if !ok {
+			return Transaction{}, errors.New("MonetaryAccount not found.")
+		}
+		if e.Amount.Currency != acc.bank.Currency {
+			return Transaction{}, errors.Errorf("The currency must be %s.", acc.bank.Currency)
+		}
+
+		balance, ok := balances[acc.bank.ID]
+		if !ok {
+			balance = amountDecimal(acc.bank.Balance)
+		}
+		balances[acc.bank.ID] = balance.Add(amountDecimal(e.Amount))
+	}
+
+	for currency, sum := range sums {
+		if !sum.IsZero() {
+			panic(errors.Errorf("bunqtest: transaction %q does not balance in %s", description, currency))
+		}
+	}
+
+	for id, balance := range balances {
+		acc := st.accounts[id]
+		if balance.LessThan(amountDecimal(acc.bank.Balance)) && balance.LessThan(amountDecimal(acc.bank.OverdraftLimit).Neg()) {
+			return Transaction{}, errInsufficientBalance
+		}
+	}
+
+	for id, balance := range balances {
+		acc := st.accounts[id]
+		acc.bank.Balance = model.MustNewAmount(balance, acc.bank.Currency)
+	}
+
+	tx := Transaction{ID: st.newID(), Time: st.now().Time, Description: description, Entries: entries}
+	st.transactions = append(st.transactions, tx)
+
+	return tx, nil
+}
+
+// requestFromSugarDaddy pays a request for money to the sugar daddy, like the sandbox of bunq.
+// It reports false if the request is not for the sugar daddy or over the amount he pays.
+func (st *store) requestFromSugarDaddy(acc *account, ri *model.RequestInquiry, counterparty model.Pointer) bool {
+	if counterparty.PType != model.PointerTypeEmail || !strings.EqualFold(counterparty.Value, sugarDaddyEmail) {
+		return false
+	}
+	if amountDecimal(ri.AmountInquired).GreaterThan(maxSugarDaddyAmount) {
+		return false
+	}
+
+	label := model.LabelMonetaryAccount{DisplayName: "Sugar Daddy", LabelUser: model.LabelUser{DisplayName: "Sugar Daddy"}}
+	if _, err := st.deposit(acc, ri.AmountInquired, label, ri.Description, "REQUEST"); err != nil {
+		return false
+	}
+
+	ri.Status = "ACCEPTED"
+	ri.AmountResponded = ri.AmountInquired
+	ri.TimeResponded = st.now()
+
+	return true
+}
+
+// resolve returns the account of the store a pointer refers to, or nil if it refers to someone outside of the store.
+// Email addresses and phone numbers refer to the first active account of their user in the currency.
+func (st *store) resolve(p model.Pointer, currency string) *account {
+	if p.PType == model.PointerTypeIBAN {
+		iban := model.NormalizeIBAN(p.Value)
+		for _, acc := range st.accounts {
+			if a := acc.bank.GetIBANPointer(); a != nil && model.NormalizeIBAN(a.Value) == iban {
+				return acc
+			}
+		}
+
+		return nil
+	}
+
+	value := normalizeAlias(p.Value)
+	for id, u := range st.users {
+		matches := false
+		for _, alias := range u.base().Alias {
+			if alias.Type == p.PType && normalizeAlias(alias.Value) == value {
+				matches = true
+			}
+		}
+		if !matches {
+			continue
+		}
+
+		for _, acc := range st.userAccounts(id) {
+			if acc.bank.Status == "ACTIVE" && acc.bank.Currency == currency {
+				return acc
+			}
+		}
+	}
+
+	return nil
+}
+
+// Ledger returns the transactions of the emulator, oldest first.
+func (s *Server) Ledger() []Transaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Transaction(nil), s.store.transactions...)
+}
+
+// VerifyLedger checks that the transactions of the emulator balance, and that the balance
+// of every monetary account is the sum of its entries.
+func (s *Server) VerifyLedger() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	balances := make(map[int]decimal.Decimal)
+	for _, tx := range s.store.transactions {
+		sums := make(map[string]decimal.Decimal)
+		for _, e := range tx.Entries {
+			sums[e.Amount.Currency] = sums[e.Amount.Currency].Add(amountDecimal(e.Amount))
+			balances[e.MonetaryAccountID] = balances[e.MonetaryAccountID].Add(amountDecimal(e.Amount))
+		}
+
+		for currency, sum := range sums {
+			if !sum.IsZero() {
+				return errors.Errorf("bunqtest: transaction %d does not balance in %s", tx.ID, currency)
+			}
+		}
+	}
+
+	for id, acc := range s.store.accounts {
+		if !balances[id].Equal(amountDecimal(acc.bank.Balance)) {
+			return errors.Errorf("bunqtest: balance of monetary account %d is %s, but its entries add up to %s", id, acc.bank.Balance, balances[id].StringFixed(2))
+		}
+	}
+
+	return nil
+}
+
+// Deposit adds money from outside of the emulator to the monetary account, like an incoming SEPA transfer.
+func (s *Server) Deposit(monetaryAccountID int, amount model.Amount, description string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.store.emulated {
+		return errors.New("bunqtest: deposits need an emulator")
+	}
+
+	acc, ok := s.store.accounts[monetaryAccountID]
+	if !ok {
+		return errors.Errorf("bunqtest: monetary account %d does not exist", monetaryAccountID)
+	}
+	if !amount.IsPositive() {
+		return errors.Errorf("bunqtest: invalid amount %s", amount)
+	}
+
+	label := model.LabelMonetaryAccount{DisplayName: "bunqtest", Country: "NL"}
+	_, err := s.store.deposit(acc, amount, label, description, "PAYMENT")
+
+	return errors.Wrapf(err, "bunqtest: could not deposit %s", amount)
+}
+
+func amountDecimal(a model.Amount) decimal.Decimal {
+	return decimal.RequireFromString(a.StringFixed())
+}
+
+func normalizeAlias(value string) string {
+	return strings.ToLower(strings.Join(strings.Fields(value), ""))
+}
@@ -0,0 +1,582 @@
+package bunqtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 200
+)
+
+// pagination is the pagination of listings, with null for the pages that don't exist like bunq.
+type pagination struct {
+	FutureURL *string `json:"future_url"`
+	NewerURL  *string `json:"newer_url"`
+	OlderURL  *string `json:"older_url"`
+}
+
+func (s *Server) registerRoutes() {
+	s.mux.HandleFunc("POST /v1/installation", s.handleInstallation)
+	s.mux.HandleFunc("POST /v1/device-server", s.handleDeviceServer)
+	s.mux.HandleFunc("POST /v1/session-server", s.handleSessionServer)
+	s.mux.HandleFunc("DELETE /v1/session/{sessionID}", s.authenticated(s.handleSessionDelete))
+
+	s.mux.HandleFunc("POST /v1/sandbox-user-person", s.handleSandboxUser)
+	s.mux.HandleFunc("POST /v1/sandbox-user-company", s.handleSandboxUser)
+
+	s.mux.HandleFunc("GET /v1/user/{userID}", s.authenticated(s.handleUserGet))
+	s.mux.HandleFunc("GET /v1/user-person/{userID}", s.authenticated(s.handleUserGet))
+	s.mux.HandleFunc("GET /v1/user-company/{userID}", s.authenticated(s.handleUserGet))
+
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account-bank", s.authenticated(s.handleMonetaryAccountBankList))
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account-bank/{accountID}", s.authenticated(s.handleMonetaryAccountBankGet))
+
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account/{accountID}/payment", s.authenticated(s.handlePaymentList))
+	s.mux.HandleFunc("POST /v1/user/{userID}/monetary-account/{accountID}/payment", s.authenticated(s.handlePaymentCreate))
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account/{accountID}/payment/{id}", s.authenticated(s.handlePaymentGet))
+
+	s.mux.HandleFunc("POST /v1/user/{userID}/monetary-account/{accountID}/payment-batch", s.authenticated(s.handlePaymentBatchCreate))
+
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account/{accountID}/draft-payment", s.authenticated(s.handleDraftPaymentList))
+	s.mux.HandleFunc("POST /v1/user/{userID}/monetary-account/{accountID}/draft-payment", s.authenticated(s.handleDraftPaymentCreate))
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account/{accountID}/draft-payment/{id}", s.authenticated(s.handleDraftPaymentGet))
+	s.mux.HandleFunc("PUT /v1/user/{userID}/monetary-account/{accountID}/draft-payment/{id}", s.authenticated(s.handleDraftPaymentUpdate))
+
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account/{accountID}/schedule-payment", s.authenticated(s.handleScheduledPaymentList))
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account/{accountID}/schedule-payment/{id}", s.authenticated(s.handleScheduledPaymentGet))
+
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account/{accountID}/request-inquiry", s.authenticated(s.handleRequestInquiryList))
+	s.mux.HandleFunc("POST /v1/user/{userID}/monetary-account/{accountID}/request-inquiry", s.authenticated(s.handleRequestInquiryCreate))
+	s.mux.HandleFunc("GET /v1/user/{userID}/monetary-account/{accountID}/request-inquiry/{id}", s.authenticated(s.handleRequestInquiryGet))
+
+	s.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Route not found.")
+	})
+}
+
+// handleSandboxUser creates a user with a monetary account and returns its API key, like the sandbox of bunq.
+func (s *Server) handleSandboxUser(w http.ResponseWriter, r *http.Request) {
+	var userID int
+	var apiKey string
+	if strings.HasSuffix(r.URL.Path, "company") {
+		userID, apiKey = s.store.addCompany(model.UserCompany{Name: "bunqtest sandbox company"})
+	} else {
+		userID, apiKey = s.store.addPerson(model.UserPerson{FirstName: "bunqtest", LastName: "sandbox person"})
+	}
+	s.store.addAccount(userID, model.MonetaryAccountBank{})
+
+	writeJSON(w, http.StatusOK, map[string][]interface{}{"Response": {
+		map[string]interface{}{"ApiKey": map[string]string{"api_key": apiKey}},
+	}})
+}
+
+func (s *Server) handleUserGet(w http.ResponseWriter, r *http.Request, sess *session) {
+	u := s.store.users[sess.userID]
+
+	switch {
+	case strings.Contains(r.URL.Path, "/user-person/") && u.person == nil,
+		strings.Contains(r.URL.Path, "/user-company/") && u.company == nil:
+		writeError(w, http.StatusNotFound, "User not found.")
+	default:
+		writeJSON(w, http.StatusOK, map[string][]interface{}{"Response": {u.wrapped()}})
+	}
+}
+
+func (s *Server) handleMonetaryAccountBankList(w http.ResponseWriter, r *http.Request, sess *session) {
+	var accounts []model.MonetaryAccountBank
+	for _, acc := range s.store.userAccounts(sess.userID) {
+		accounts = append(accounts, acc.bank)
+	}
+
+	page, p, ok := paginate(w, r, accounts, func(a model.MonetaryAccountBank) int { return a.ID })
+	if ok {
+		writeObjects(w, "MonetaryAccountBank", page, p)
+	}
+}
+
+func (s *Server) handleMonetaryAccountBankGet(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if ok {
+		writeObjects(w, "MonetaryAccountBank", []model.MonetaryAccountBank{acc.bank}, nil)
+	}
+}
+
+func (s *Server) handlePaymentList(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	page, p, ok := paginate(w, r, acc.payments, func(p model.Payment) int { return p.ID })
+	if ok {
+		writeObjects(w, "Payment", page, p)
+	}
+}
+
+func (s *Server) handlePaymentGet(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	id := pathID(r, "id")
+	for _, p := range acc.payments {
+		if p.ID == id {
+			writeObjects(w, "Payment", []model.Payment{p}, nil)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "Payment not found.")
+}
+
+// handlePaymentCreate makes a payment from the monetary account.
+func (s *Server) handlePaymentCreate(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	var body model.PaymentCreate
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body.")
+		return
+	}
+	if msg := validatePayment(body.Amount, body.CounterpartyAlias, acc); msg != "" {
+		writeError(w, http.StatusBadRequest, msg)
+		return
+	}
+
+	payments, err := s.store.pay(acc, []payment{{
+		amount:       body.Amount,
+		counterparty: body.CounterpartyAlias,
+		description:  body.Description,
+	}})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeCreated(w, payments[0].ID)
+}
+
+// handlePaymentBatchCreate makes the payments of the batch from the monetary account at once.
+func (s *Server) handlePaymentBatchCreate(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	var body model.PaymentBatchCreate
+	if err := decodeBody(r, &body); err != nil || len(body.Payments) == 0 {
+		writeError(w, http.StatusBadRequest, "Invalid request body.")
+		return
+	}
+
+	batchID := s.store.newID()
+	payments := make([]payment, 0, len(body.Payments))
+	for _, p := range body.Payments {
+		if msg := validatePayment(p.Amount, p.CounterpartyAlias, acc); msg != "" {
+			writeError(w, http.StatusBadRequest, msg)
+			return
+		}
+
+		payments = append(payments, payment{
+			amount:       p.Amount,
+			counterparty: p.CounterpartyAlias,
+			description:  p.Description,
+			batchID:      batchID,
+		})
+	}
+
+	if _, err := s.store.pay(acc, payments); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeCreated(w, batchID)
+}
+
+func (s *Server) handleDraftPaymentList(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	drafts := make([]model.DraftPayment, 0, len(acc.drafts))
+	for _, d := range acc.drafts {
+		drafts = append(drafts, d.draft)
+	}
+
+	page, p, ok := paginate(w, r, drafts, func(d model.DraftPayment) int { return d.ID })
+	if ok {
+		writeObjects(w, "DraftPayment", page, p)
+	}
+}
+
+func (s *Server) handleDraftPaymentGet(w http.ResponseWriter, r *http.Request, sess *session) {
+	d, ok := s.userDraft(w, r, sess)
+	if ok {
+		writeObjects(w, "DraftPayment", []model.DraftPayment{d.draft}, nil)
+	}
+}
+
+// handleDraftPaymentCreate adds a draft payment to the monetary account, which waits until it is accepted.
+func (s *Server) handleDraftPaymentCreate(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	var body model.RequestCreateDraftPayment
+	if err := decodeBody(r, &body); err != nil || len(body.Entries) == 0 {
+		writeError(w, http.StatusBadRequest, "Invalid request body.")
+		return
+	}
+	entries, msg := s.store.draftEntries(acc, body.Entries)
+	if msg != "" {
+		writeError(w, http.StatusBadRequest, msg)
+		return
+	}
+
+	t := s.store.now()
+	d := &draft{
+		draft: model.DraftPayment{
+			Common:            model.Common{ID: s.store.newID(), Created: t, Updated: t},
+			MonetaryAccountID: acc.bank.ID,
+			Status:            "PENDING",
+			Type:              "MANUAL",
+			UserAliasCreated:  s.store.label(acc).LabelUser,
+			Entries:           entries,
+		},
+		entries: body.Entries,
+	}
+	acc.drafts = append(acc.drafts, d)
+
+	writeCreated(w, d.draft.ID)
+}
+
+// handleDraftPaymentUpdate replaces the entries of a pending draft payment.
+func (s *Server) handleDraftPaymentUpdate(w http.ResponseWriter, r *http.Request, sess *session) {
+	d, ok := s.userDraft(w, r, sess)
+	if !ok {
+		return
+	}
+	if d.draft.Status != "PENDING" {
+		writeError(w, http.StatusBadRequest, "The draft payment is not pending anymore.")
+		return
+	}
+
+	var body model.RequestUpdateDraftPayment
+	if err := decodeBody(r, &body); err != nil || len(body.Entries) == 0 {
+		writeError(w, http.StatusBadRequest, "Invalid request body.")
+		return
+	}
+	entries, msg := s.store.draftEntries(s.store.accounts[d.draft.MonetaryAccountID], body.Entries)
+	if msg != "" {
+		writeError(w, http.StatusBadRequest, msg)
+		return
+	}
+
+	d.draft.Entries = entries
+	d.draft.Updated = s.store.now()
+	d.entries = body.Entries
+
+	writeCreated(w, d.draft.ID)
+}
+
+func (s *Server) handleScheduledPaymentList(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	var scheduled []model.ScheduledPayment
+	for _, sc := range s.store.schedules {
+		if sc.scheduled.MonetaryAccountID == acc.bank.ID {
+			scheduled = append(scheduled, *sc.scheduled)
+		}
+	}
+
+	page, p, ok := paginate(w, r, scheduled, func(sp model.ScheduledPayment) int { return sp.ID })
+	if ok {
+		writeObjects(w, "ScheduledPayment", page, p)
+	}
+}
+
+func (s *Server) handleScheduledPaymentGet(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	id := pathID(r, "id")
+	for _, sc := range s.store.schedules {
+		if sc.scheduled.MonetaryAccountID == acc.bank.ID && sc.scheduled.ID == id {
+			writeObjects(w, "ScheduledPayment", []model.ScheduledPayment{*sc.scheduled}, nil)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "ScheduledPayment not found.")
+}
+
+func (s *Server) handleRequestInquiryList(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	page, p, ok := paginate(w, r, acc.requestInquiries, func(ri model.RequestInquiry) int { return ri.ID })
+	if ok {
+		writeObjects(w, "RequestInquiry", page, p)
+	}
+}
+
+func (s *Server) handleRequestInquiryGet(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	id := pathID(r, "id")
+	for _, ri := range acc.requestInquiries {
+		if ri.ID == id {
+			writeObjects(w, "RequestInquiry", []model.RequestInquiry{ri}, nil)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "RequestInquiry not found.")
+}
+
+// handleRequestInquiryCreate adds a pending request for money to the monetary account.
+func (s *Server) handleRequestInquiryCreate(w http.ResponseWriter, r *http.Request, sess *session) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return
+	}
+
+	var body model.RequestInquiryCreate
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body.")
+		return
+	}
+	if msg := validatePayment(body.AmountInquired, body.CounterpartyAlias, acc); msg != "" {
+		writeError(w, http.StatusBadRequest, msg)
+		return
+	}
+
+	t := s.store.now()
+	ri := model.RequestInquiry{
+		Common:            model.Common{ID: s.store.newID(), Created: t, Updated: t},
+		MonetaryAccountID: acc.bank.ID,
+		AmountInquired:    parsedAmount(body.AmountInquired),
+		UserAliasCreated:  s.store.label(acc).LabelUser,
+		CounterpartyAlias: pointerLabel(body.CounterpartyAlias),
+		Description:       body.Description,
+		MerchantReference: body.MerchantReference,
+		RedirectURL:       body.RedirectURL,
+		Status:            "PENDING",
+	}
+	if s.store.emulated {
+		s.store.requestFromSugarDaddy(acc, &ri, body.CounterpartyAlias)
+	}
+	acc.requestInquiries = append(acc.requestInquiries, ri)
+
+	writeCreated(w, ri.ID)
+}
+
+// userAccount returns the monetary account of the path, which must belong to the user of the session,
+// and writes the error if it does not.
+func (s *Server) userAccount(w http.ResponseWriter, r *http.Request, sess *session) (*account, bool) {
+	id := pathID(r, "accountID")
+
+	acc, ok := s.store.accounts[id]
+	if !ok || acc.bank.UserID != sess.userID {
+		writeError(w, http.StatusNotFound, "MonetaryAccount not found.")
+		return nil, false
+	}
+
+	return acc, true
+}
+
+// paginate returns the page of the items, which are sorted by id, that the count, newer_id and older_id
+// parameters select, newest first like bunq, and its pagination. It writes the error if the parameters are invalid.
+func paginate[T any](w http.ResponseWriter, r *http.Request, items []T, id func(T) int) ([]T, *pagination, bool) {
+	q := r.URL.Query()
+
+	count := defaultPageSize
+	if v := q.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("The count must be between 1 and %d.", maxPageSize))
+			return nil, nil, false
+		}
+		count = n
+	}
+
+	var page []T
+	switch {
+	case q.Has("older_id"):
+		olderID, _ := strconv.Atoi(q.Get("older_id"))
+		for i := len(items) - 1; i >= 0 && len(page) < count; i-- {
+			if id(items[i]) < olderID {
+				page = append(page, items[i])
+			}
+		}
+	case q.Has("newer_id"):
+		newerID, _ := strconv.Atoi(q.Get("newer_id"))
+		for i := 0; i < len(items) && len(page) < count; i++ {
+			if id(items[i]) > newerID {
+				page = append([]T{items[i]}, page...)
+			}
+		}
+	default:
+		for i := len(items) - 1; i >= 0 && len(page) < count; i-- {
+			page = append(page, items[i])
+		}
+	}
+
+	p := &pagination{}
+	pageURL := func(param string, id int) *string {
+		v := url.Values{"count": {strconv.Itoa(count)}, param: {strconv.Itoa(id)}}
+		u := r.URL.Path + "?" + v.Encode()
+		return &u
+	}
+
+	if len(page) == 0 {
+		if q.Has("newer_id") {
+			newerID, _ := strconv.Atoi(q.Get("newer_id"))
+			p.FutureURL = pageURL("newer_id", newerID)
+		}
+		return page, p, true
+	}
+
+	newest, oldest := id(page[0]), id(page[len(page)-1])
+	if len(items) > 0 && id(items[0]) < oldest {
+		p.OlderURL = pageURL("older_id", oldest)
+	}
+	if len(items) > 0 && id(items[len(items)-1]) > newest {
+		p.NewerURL = pageURL("newer_id", newest)
+	} else {
+		p.FutureURL = pageURL("newer_id", newest)
+	}
+
+	return page, p, true
+}
+
+// writeObjects writes the objects in the response format of bunq, wrapped in objects with key as type.
+func writeObjects[T any](w http.ResponseWriter, key string, objects []T, p *pagination) {
+	res := struct {
+		Response   []map[string]T `json:"Response"`
+		Pagination *pagination    `json:"Pagination,omitempty"`
+	}{Response: make([]map[string]T, 0, len(objects)), Pagination: p}
+
+	for _, o := range objects {
+		res.Response = append(res.Response, map[string]T{key: o})
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// writeCreated writes the id of a created object.
+func writeCreated(w http.ResponseWriter, id int) {
+	writeJSON(w, http.StatusOK, model.ResponseBunqID{Response: []model.WrappedBunqID{{ID: model.BunqID{ID: id}}}})
+}
+
+// pathID returns the id in the path, or zero if it is not a number.
+func pathID(r *http.Request, name string) int {
+	id, _ := strconv.Atoi(r.PathValue(name))
+	return id
+}
+
+// userDraft returns the draft payment of the path, which must belong to the user of the session,
+// and writes the error if it does not.
+func (s *Server) userDraft(w http.ResponseWriter, r *http.Request, sess *session) (*draft, bool) {
+	acc, ok := s.userAccount(w, r, sess)
+	if !ok {
+		return nil, false
+	}
+
+	id := pathID(r, "id")
+	for _, d := range acc.drafts {
+		if d.draft.ID == id {
+			return d, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "DraftPayment not found.")
+	return nil, false
+}
+
+// draftEntries validates the entries of a draft payment and returns them as they are shown.
+func (st *store) draftEntries(acc *account, create []model.DraftPaymentEntryCreate) ([]model.DraftPaymentEntry, string) {
+	entries := make([]model.DraftPaymentEntry, 0, len(create))
+	for _, e := range create {
+		if msg := validatePayment(e.Amount, e.CounterpartyAlias, acc); msg != "" {
+			return nil, msg
+		}
+
+		entry := model.DraftPaymentEntry{
+			Amount:            parsedAmount(e.Amount),
+			Alias:             st.label(acc),
+			CounterpartyAlias: pointerLabel(e.CounterpartyAlias),
+			Description:       e.Description,
+		}
+		if e.MerchantReference != nil {
+			entry.MerchantReference = *e.MerchantReference
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, ""
+}
+
+// validatePayment returns the description of the error if the amount is not a positive amount
+// in the currency of the account or the counterparty is invalid, or an empty string.
+func validatePayment(a model.Amount, counterparty model.Pointer, acc *account) string {
+	d, err := decimal.NewFromString(a.Value)
+	if err != nil || !d.IsPositive() {
+		return "The amount must be positive."
+	}
+	if !d.Equal(d.Round(2)) {
+		return "The amount can have at most two decimals."
+	}
+	if a.Currency != acc.bank.Currency {
+		return fmt.Sprintf("The currency must be %s.", acc.bank.Currency)
+	}
+	if err := counterparty.Validate(); err != nil {
+		return "The counterparty alias is invalid."
+	}
+
+	return ""
+}
+
+// parsedAmount returns the amount, which was decoded from a request, with its Decimal set and rounded to cents.
+func parsedAmount(a model.Amount) model.Amount {
+	return model.MustNewAmount(amountDecimal(a), a.Currency)
+}
+
+// pointerLabel returns the label of a counterparty given by a pointer.
+func pointerLabel(p model.Pointer) model.LabelMonetaryAccount {
+	l := model.LabelMonetaryAccount{}
+	if p.Name != nil {
+		l.DisplayName = *p.Name
+	} else {
+		l.DisplayName = p.Value
+	}
+	if p.PType == model.PointerTypeIBAN {
+		l.IBAN = model.NormalizeIBAN(p.Value)
+	}
+
+	return l
+}
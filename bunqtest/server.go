@@ -0,0 +1,374 @@
+// Package bunqtest provides a fake bunq API for tests, running in-process on an httptest.Server.
+//
+// The server performs the installation, device-server and session-server handshakes like bunq,
+// verifies the signatures of the requests and signs its responses with its own key, so a
+// bunq.Client talks to it like to the real API. Users, monetary accounts and payments are kept
+// in memory:
+//
+//	srv := bunqtest.NewServer()
+//	defer srv.Close()
+//
+//	userID, apiKey := srv.AddUserPerson(model.UserPerson{})
+//	accountID := srv.AddMonetaryAccountBank(userID, model.MonetaryAccountBank{})
+//
+//	c, err := srv.NewClient(ctx, apiKey)
+//
+// Failures like rate limiting can be injected with Fail.
+//
+// NewEmulator starts a server that books payments in a ledger and changes the balances of the
+// accounts, with a clock that only moves when it is advanced.
+//
+// A Cassette records the interactions of a client with the real API and replays them in tests.
+package bunqtest
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	headerAuthentication   = "X-Bunq-Client-Authentication"
+	headerClientSignature  = "X-Bunq-Client-Signature"
+	headerServerSignature  = "X-Bunq-Server-Signature"
+	headerClientRequestID  = "X-Bunq-Client-Request-Id"
+	headerClientResponseID = "X-Bunq-Client-Response-Id"
+
+	// pathPrefix is the path of BaseURL.
+	pathPrefix = "/v1/"
+
+	deviceDescription  = "go-bunq bunqtest"
+	maxRequestBodySize = 1 << 20
+)
+
+// Server is a fake bunq API. Its methods may be called concurrently with the requests of clients.
+type Server struct {
+	*httptest.Server
+	// BaseURL is the URL clients use instead of bunq.BaseURLSandbox or bunq.BaseURLProduction.
+	BaseURL string
+
+	key          *rsa.PrivateKey
+	publicKeyPEM string
+	mux          *http.ServeMux
+
+	// mu guards the store. It is held while a request is handled.
+	mu            sync.Mutex
+	store         *store
+	installations map[string]*installation
+	sessions      map[string]*session
+	failures      []*Failure
+	requests      []Request
+}
+
+// Failure makes the server fail requests, to test how clients handle errors.
+type Failure struct {
+	// Method of the requests that fail, all methods if empty.
+	Method string
+	// Path of the requests that fail relative to BaseURL, like "user/*/monetary-account-bank".
+	// It is matched with path.Match; all paths match if it is empty.
+	Path string
+	// Status the requests fail with, like http.StatusTooManyRequests or http.StatusInternalServerError.
+	Status int
+	// InvalidSignature makes the server handle the requests, but sign the responses with a wrong signature.
+	InvalidSignature bool
+	// Times is how many requests fail. If it is zero, requests fail until ResetFailures is called.
+	Times int
+}
+
+// Request is a request the server received.
+type Request struct {
+	Method string
+	// Path of the request relative to BaseURL, like "user/1/monetary-account-bank".
+	Path  string
+	Query url.Values
+	Body  []byte
+}
+
+// installation is a client public key registered with the installation endpoint.
+type installation struct {
+	id        int
+	token     string
+	clientKey *rsa.PublicKey
+	// secrets are the API keys that were registered as device for the installation.
+	secrets map[string]bool
+}
+
+type session struct {
+	id           int
+	token        string
+	installation *installation
+	userID       int
+}
+
+// NewServer starts a fake bunq API with a new server key and an empty store.
+// It must be closed with Close when it is not needed anymore.
+func NewServer() *Server {
+	return newServer(newStore())
+}
+
+func newServer(st *store) *Server {
+	key, publicKeyPEM := newServerKey()
+
+	s := &Server{
+		key:           key,
+		publicKeyPEM:  publicKeyPEM,
+		mux:           http.NewServeMux(),
+		store:         st,
+		installations: make(map[string]*installation),
+		sessions:      make(map[string]*session),
+	}
+	s.registerRoutes()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.BaseURL = s.URL + pathPrefix
+
+	return s
+}
+
+// NewClient creates a client for the API key and initialises it, which performs the handshakes.
+// The client must be closed when it is not needed anymore.
+func (s *Server) NewClient(ctx context.Context, apiKey string) (*bunq.Client, error) {
+	key, err := bunq.CreateNewKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	c := bunq.NewClient(ctx, s.BaseURL, key, apiKey, deviceDescription, bunq.WildcardIP)
+	c.Client = s.Client()
+
+	if err := c.Init(); err != nil {
+		_ = c.Close()
+		return nil, errors.Wrap(err, "bunqtest: could not initialise client")
+	}
+
+	return c, nil
+}
+
+// Fail makes the requests matching f fail.
+func (s *Server) Fail(f Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, &f)
+}
+
+// ResetFailures removes all failures added with Fail.
+func (s *Server) ResetFailures() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = nil
+}
+
+// Requests returns the requests the server received, oldest first.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// PublicKey returns the public key the server signs its responses with.
+func (s *Server) PublicKey() *rsa.PublicKey {
+	return &s.key.PublicKey
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBodySize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Could not read the request body.")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   strings.TrimPrefix(r.URL.Path, pathPrefix),
+		Query:  r.URL.Query(),
+		Body:   body,
+	})
+
+	// The response is recorded first, so it can be signed.
+	rec := httptest.NewRecorder()
+	failure := s.failure(r)
+	if failure != nil && failure.Status != 0 {
+		writeError(rec, failure.Status, http.StatusText(failure.Status))
+	} else {
+		s.mux.ServeHTTP(rec, r)
+	}
+	s.mu.Unlock()
+
+	res := rec.Result()
+	resBody := rec.Body.Bytes()
+
+	for k, v := range res.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Set(headerClientResponseID, uuid.New().String())
+	if id := r.Header.Get(headerClientRequestID); id != "" {
+		w.Header().Set(headerClientRequestID, id)
+	}
+
+	signed := resBody
+	if failure != nil && failure.InvalidSignature {
+		signed = append([]byte("invalid"), resBody...)
+	}
+	w.Header().Set(headerServerSignature, s.sign(signed))
+
+	w.WriteHeader(res.StatusCode)
+	_, _ = w.Write(resBody)
+}
+
+// failure returns the failure matching the request and counts it, or nil if there is none.
+// It must be called with the lock held.
+func (s *Server) failure(r *http.Request) *Failure {
+	p := strings.TrimPrefix(r.URL.Path, pathPrefix)
+
+	for i, f := range s.failures {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if f.Path != "" {
+			if ok, _ := path.Match(f.Path, p); !ok {
+				continue
+			}
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.failures = append(s.failures[:i:i], s.failures[i+1:]...)
+			}
+		}
+
+		return f
+	}
+
+	return nil
+}
+
+// sign signs the body with the key of the server.
+func (s *Server) sign(body []byte) string {
+	return signBody(s.key, body)
+}
+
+// newServerKey generates a server key and returns it with its public key in PEM, like bunq sends it on installation.
+func newServerKey() (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(errors.Wrap(err, "bunqtest: could not generate server key"))
+	}
+
+	pubKey, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		panic(errors.Wrap(err, "bunqtest: could not marshal server public key"))
+	}
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubKey}))
+}
+
+// signBody signs the body of a response like bunq, over its SHA256 hash without trailing newline.
+func signBody(key *rsa.PrivateKey, body []byte) string {
+	h := sha256.Sum256(bytes.TrimSuffix(body, []byte("\n")))
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, h[:])
+	if err != nil {
+		panic(errors.Wrap(err, "bunqtest: could not sign response"))
+	}
+
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+// verifySignature checks the signature of the request body. Requests without body are signed over a newline.
+func verifySignature(key *rsa.PublicKey, r *http.Request) bool {
+	sig, err := base64.StdEncoding.DecodeString(r.Header.Get(headerClientSignature))
+	if err != nil || len(sig) == 0 {
+		return false
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		return false
+	}
+
+	candidates := [][]byte{body}
+	if len(body) == 0 {
+		candidates = append(candidates, []byte("\n"))
+	}
+
+	for _, c := range candidates {
+		h := sha256.Sum256(c)
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, h[:], sig) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// readBody reads the body of the request, which stays readable afterwards.
+func readBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, err
+}
+
+func decodeBody(r *http.Request, v interface{}) error {
+	body, err := readBody(r)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+func writeError(w http.ResponseWriter, status int, description string) {
+	writeJSON(w, status, model.ResponseError{Error: []model.BunqError{{
+		ErrorDescription:           description,
+		ErrorDescriptionTranslated: description,
+	}}})
+}
+
+// newToken returns a random token, like the installation and session tokens and API keys of bunq.
+func newToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(errors.Wrap(err, "bunqtest: could not create token"))
+	}
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,214 @@
+package bunqtest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestServer returns a server that is closed after the clients of the test.
+func newTestServer(t *testing.T) *Server {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newTestClient(t *testing.T, srv *Server, apiKey string) *bunq.Client {
+	t.Helper()
+
+	c, err := srv.NewClient(context.Background(), apiKey)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, c.Close()) })
+
+	return c
+}
+
+func TestHandshake(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	userID, apiKey := srv.AddUserPerson(model.UserPerson{FirstName: "Ada", LastName: "Lovelace"})
+	c := newTestClient(t, srv, apiKey)
+
+	assert.True(t, c.IsUserPerson())
+	id, err := c.GetUserID()
+	require.NoError(t, err)
+	assert.Equal(t, userID, id)
+
+	u, err := c.UserService.GetUser()
+	require.NoError(t, err)
+	assert.Equal(t, "Ada Lovelace", u.UserDisplayName())
+
+	var paths []string
+	for _, r := range srv.Requests() {
+		paths = append(paths, r.Path)
+	}
+	assert.Equal(t, []string{"installation", "device-server", "session-server", "user/" + strconv.Itoa(userID)}, paths)
+}
+
+func TestHandshakeCompany(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	_, apiKey := srv.AddUserCompany(model.UserCompany{Name: "bunq B.V."})
+	c := newTestClient(t, srv, apiKey)
+
+	assert.True(t, c.IsUserCompany())
+	res, err := c.UserService.GetUserCompany()
+	require.NoError(t, err)
+	assert.Equal(t, "bunq B.V.", res.UserCompany().DisplayName)
+
+	_, err = c.UserService.GetUserPerson()
+	assert.ErrorContains(t, err, "User not found.")
+}
+
+func TestUnknownAPIKey(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	_, err := srv.NewClient(context.Background(), "sandbox_unknown")
+	assert.ErrorContains(t, err, errDescriptionCredentials)
+}
+
+func TestMonetaryAccountsAndPayments(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	userID, apiKey := srv.AddUserPerson(model.UserPerson{})
+	accountID := srv.AddMonetaryAccountBank(userID, model.MonetaryAccountBank{Balance: model.MustParseAmount("100.00 EUR")})
+	for i := 0; i < 3; i++ {
+		srv.AddPayment(accountID, model.Payment{Amount: model.MustParseAmount("-1.00 EUR")})
+	}
+
+	otherUserID, _ := srv.AddUserPerson(model.UserPerson{})
+	otherAccountID := srv.AddMonetaryAccountBank(otherUserID, model.MonetaryAccountBank{})
+
+	c := newTestClient(t, srv, apiKey)
+
+	accounts, err := c.AccountService.GetAllMonetaryAccountBank()
+	require.NoError(t, err)
+	require.Len(t, accounts.MonetaryAccountBanks(), 1)
+	acc := accounts.MonetaryAccountBanks()[0]
+	assert.Equal(t, accountID, acc.ID)
+	assert.Equal(t, "100.00 EUR", acc.Balance.String())
+	require.NotNil(t, acc.GetIBANPointer())
+	assert.NoError(t, model.ValidateIBAN(acc.GetIBANPointer().Value))
+
+	_, err = c.AccountService.GetMonetaryAccountBank(otherAccountID)
+	assert.ErrorContains(t, err, "MonetaryAccount not found.")
+
+	count := func(q url.Values) error {
+		q.Set("count", "2")
+		return nil
+	}
+	page, err := c.PaymentService.GetAllPayment(accountID, count)
+	require.NoError(t, err)
+	assert.Len(t, page.Payments(), 2)
+	require.True(t, page.Pagination.HasPrevious())
+
+	older, err := c.PaymentService.GetAllPayment(accountID, page.Pagination.PreviousPage())
+	require.NoError(t, err)
+	assert.Len(t, older.Payments(), 1)
+	assert.False(t, older.Pagination.HasPrevious())
+
+	counterparty, err := model.IBANPointer("NL02ABNA0123456789", "Grace Hopper")
+	require.NoError(t, err)
+	created, err := c.PaymentService.CreatePayment(accountID, model.PaymentCreate{
+		Amount:            model.MustParseAmount("12.50 EUR"),
+		CounterpartyAlias: counterparty,
+		Description:       "Lunch",
+	})
+	require.NoError(t, err)
+
+	payments := srv.Payments(accountID)
+	require.Len(t, payments, 4)
+	p := payments[3]
+	assert.Equal(t, created.ID(), p.ID)
+	assert.Equal(t, "-12.50 EUR", p.Amount.String())
+	assert.Equal(t, "NL02ABNA0123456789", p.CounterpartyAlias.IBAN)
+	assert.Equal(t, "Grace Hopper", p.CounterpartyAlias.DisplayName)
+
+	_, err = c.PaymentService.CreatePayment(accountID, model.PaymentCreate{
+		Amount:            model.MustParseAmount("12.50 USD"),
+		CounterpartyAlias: counterparty,
+	})
+	assert.ErrorContains(t, err, "The currency must be EUR.")
+}
+
+func TestFailures(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	userID, apiKey := srv.AddUserPerson(model.UserPerson{})
+	c := newTestClient(t, srv, apiKey)
+
+	srv.Fail(Failure{Path: "user/*", Status: http.StatusInternalServerError, Times: 1})
+	_, err := c.UserService.GetUser()
+	assert.True(t, errors.Is(err, bunq.ErrInternalServerError), err)
+
+	srv.Fail(Failure{Method: http.MethodGet, Path: "user/*", InvalidSignature: true, Times: 1})
+	_, err = c.UserService.GetUser()
+	assert.True(t, errors.Is(err, bunq.ErrResponseVerificationFailed), err)
+
+	// The client retries rate limited requests.
+	srv.Fail(Failure{Path: "user/*", Status: http.StatusTooManyRequests, Times: 1})
+	u, err := c.UserService.GetUser()
+	require.NoError(t, err)
+	assert.Equal(t, userID, u.UserID())
+
+	c.DisableBackoff = true
+	srv.Fail(Failure{Status: http.StatusTooManyRequests})
+	_, err = c.UserService.GetUser()
+	assert.True(t, errors.Is(err, bunq.ErrRateLimitExceeded), err)
+
+	srv.ResetFailures()
+	_, err = c.UserService.GetUser()
+	assert.NoError(t, err)
+}
+
+func TestAuthentication(t *testing.T) {
+	t.Parallel()
+
+	srv := newTestServer(t)
+
+	userID, apiKey := srv.AddUserPerson(model.UserPerson{})
+	c := newTestClient(t, srv, apiKey)
+
+	// Requests need a session token and a valid signature.
+	res, err := srv.Client().Get(srv.BaseURL + "user/" + strconv.Itoa(userID))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	clientCtx, err := c.ExportClientContext()
+	require.NoError(t, err)
+	r, err := http.NewRequest(http.MethodGet, srv.BaseURL+"user/"+strconv.Itoa(userID), nil)
+	require.NoError(t, err)
+	r.Header.Set(headerAuthentication, clientCtx.SessionServerContext.Token.Token)
+	res, err = srv.Client().Do(r)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestNewIBAN(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{0, 1, 42, 123456789, 1234567890} {
+		assert.NoError(t, model.ValidateIBAN(newIBAN(n)), n)
+	}
+}
@@ -0,0 +1,361 @@
+package bunqtest
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// defaultSessionTimeout is the session timeout of the users of the store, in seconds,
+// unless they have one set.
+const defaultSessionTimeout = 3600
+
+// store holds the users, monetary accounts and payments the server serves.
+type store struct {
+	lastID   int
+	apiKeys  map[string]int
+	users    map[int]*user
+	accounts map[int]*account
+
+	// emulated stores book payments in the ledger and use the clock instead of the real time.
+	emulated     bool
+	clock        time.Time
+	transactions []Transaction
+	schedules    []*schedule
+}
+
+// user is a person or a company.
+type user struct {
+	person  *model.UserPerson
+	company *model.UserCompany
+}
+
+type account struct {
+	bank             model.MonetaryAccountBank
+	payments         []model.Payment
+	requestInquiries []model.RequestInquiry
+	drafts           []*draft
+}
+
+func newStore() *store {
+	return &store{
+		apiKeys:  make(map[string]int),
+		users:    make(map[int]*user),
+		accounts: make(map[int]*account),
+	}
+}
+
+// now returns the time of the store, which is the real time unless the store is emulated.
+func (st *store) now() model.Time {
+	if st.emulated {
+		return model.NewTime(st.clock)
+	}
+
+	return model.NewTime(time.Now())
+}
+
+// newID returns a new id. All objects of the store share the ids, like the ids in bunq are unique across types.
+func (st *store) newID() int {
+	st.lastID++
+	return st.lastID
+}
+
+// id returns id, or a new id if it is zero.
+func (st *store) id(id int) int {
+	if id == 0 {
+		return st.newID()
+	}
+	if id > st.lastID {
+		st.lastID = id
+	}
+
+	return id
+}
+
+// AddUserPerson adds a person and returns its id and a new API key of the person.
+// Unset fields get defaults, like the id, status ACTIVE and a session timeout of an hour.
+func (s *Server) AddUserPerson(p model.UserPerson) (userID int, apiKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store.addPerson(p)
+}
+
+// AddUserCompany adds a company and returns its id and a new API key of the company.
+// Unset fields get defaults, like the id, status ACTIVE and a session timeout of an hour.
+func (s *Server) AddUserCompany(c model.UserCompany) (userID int, apiKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store.addCompany(c)
+}
+
+// AddMonetaryAccountBank adds a monetary account of the user and returns its id.
+// Unset fields get defaults, like the id, status ACTIVE, currency EUR, a zero balance and an IBAN alias.
+// It panics if the user does not exist.
+func (s *Server) AddMonetaryAccountBank(userID int, acc model.MonetaryAccountBank) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.store.users[userID]; !ok {
+		panic(fmt.Sprintf("bunqtest: user %d does not exist", userID))
+	}
+
+	return s.store.addAccount(userID, acc)
+}
+
+// AddPayment adds a payment to the monetary account and returns its id. The balance of the account is not changed,
+// not even by an emulator; use Deposit to add money to an account of an emulator.
+// Unset fields get defaults, like the id and the alias of the account. It panics if the account does not exist.
+func (s *Server) AddPayment(monetaryAccountID int, p model.Payment) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.store.accounts[monetaryAccountID]
+	if !ok {
+		panic(fmt.Sprintf("bunqtest: monetary account %d does not exist", monetaryAccountID))
+	}
+
+	p.ID = s.store.id(p.ID)
+	p.MonetaryAccountID = monetaryAccountID
+	if p.Created.IsZero() {
+		p.Created = s.store.now()
+		p.Updated = p.Created
+	}
+	if p.Alias.IBAN == "" && p.Alias.DisplayName == "" {
+		p.Alias = s.store.label(acc)
+	}
+	if p.Type == "" {
+		p.Type = "BUNQ"
+	}
+
+	acc.addPayment(p)
+
+	return p.ID
+}
+
+// MonetaryAccountBank returns the monetary account, and false if it does not exist.
+func (s *Server) MonetaryAccountBank(monetaryAccountID int) (model.MonetaryAccountBank, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.store.accounts[monetaryAccountID]
+	if !ok {
+		return model.MonetaryAccountBank{}, false
+	}
+
+	return acc.bank, true
+}
+
+// Payments returns the payments of the monetary account, oldest first.
+func (s *Server) Payments(monetaryAccountID int) []model.Payment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.store.accounts[monetaryAccountID]
+	if !ok {
+		return nil
+	}
+
+	return append([]model.Payment(nil), acc.payments...)
+}
+
+// RequestInquiries returns the requests for money sent from the monetary account, oldest first.
+func (s *Server) RequestInquiries(monetaryAccountID int) []model.RequestInquiry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	acc, ok := s.store.accounts[monetaryAccountID]
+	if !ok {
+		return nil
+	}
+
+	return append([]model.RequestInquiry(nil), acc.requestInquiries...)
+}
+
+func (st *store) addPerson(p model.UserPerson) (int, string) {
+	if p.DisplayName == "" {
+		p.DisplayName = strings.TrimSpace(p.FirstName + " " + p.LastName)
+		if p.DisplayName == "" {
+			p.DisplayName = "bunqtest person"
+		}
+	}
+	st.setUserDefaults(&p.BaseUser)
+
+	return st.addUser(&user{person: &p}, p.ID)
+}
+
+func (st *store) addCompany(c model.UserCompany) (int, string) {
+	if c.DisplayName == "" {
+		c.DisplayName = c.Name
+		if c.Name == "" {
+			c.DisplayName = "bunqtest company"
+		}
+	}
+	st.setUserDefaults(&c.BaseUser)
+
+	return st.addUser(&user{company: &c}, c.ID)
+}
+
+func (st *store) addAccount(userID int, acc model.MonetaryAccountBank) int {
+	acc.ID = st.id(acc.ID)
+	acc.UserID = userID
+	if acc.Created.IsZero() {
+		acc.Created = st.now()
+		acc.Updated = acc.Created
+	}
+	if acc.Status == "" {
+		acc.Status = "ACTIVE"
+	}
+	if acc.Currency == "" {
+		acc.Currency = "EUR"
+	}
+	if acc.Balance.Currency == "" {
+		acc.Balance = model.MustNewAmount(decimal.Zero, acc.Currency)
+	}
+	if acc.Description == "" {
+		acc.Description = fmt.Sprintf("Account %d", acc.ID)
+	}
+	if acc.PublicUUID == "" {
+		acc.PublicUUID = uuid.New().String()
+	}
+	if acc.GetIBANPointer() == nil {
+		name := st.users[userID].displayName()
+		acc.Alias = append(acc.Alias, model.Pointer{PType: model.PointerTypeIBAN, Value: newIBAN(acc.ID), Name: &name})
+	}
+
+	st.accounts[acc.ID] = &account{bank: acc}
+
+	// The ledger starts with the balance the account was added with.
+	if st.emulated && !acc.Balance.IsZero() {
+		st.transactions = append(st.transactions, Transaction{
+			ID:          st.newID(),
+			Time:        acc.Created.Time,
+			Description: "Opening balance",
+			Entries: []Entry{
+				{Amount: acc.Balance.Neg()},
+				{MonetaryAccountID: acc.ID, Amount: acc.Balance},
+			},
+		})
+	}
+
+	return acc.ID
+}
+
+func (st *store) setUserDefaults(u *model.BaseUser) {
+	if u.Created.IsZero() {
+		u.Created = st.now()
+		u.Updated = u.Created
+	}
+	if u.PublicUUID == "" {
+		u.PublicUUID = uuid.New().String()
+	}
+	if u.Status == "" {
+		u.Status = "ACTIVE"
+	}
+	if u.SessionTimeout == 0 {
+		u.SessionTimeout = defaultSessionTimeout
+	}
+	if u.PublicNickName == "" {
+		u.PublicNickName = u.DisplayName
+	}
+}
+
+func (st *store) addUser(u *user, id int) (int, string) {
+	id = st.id(id)
+	if u.person != nil {
+		u.person.ID = id
+	} else {
+		u.company.ID = id
+	}
+
+	apiKey := "sandbox_" + newToken()
+	st.users[id] = u
+	st.apiKeys[apiKey] = id
+
+	return id, apiKey
+}
+
+// userAccounts returns the monetary accounts of the user, sorted by id.
+func (st *store) userAccounts(userID int) []*account {
+	var accounts []*account
+	for _, acc := range st.accounts {
+		if acc.bank.UserID == userID {
+			accounts = append(accounts, acc)
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].bank.ID < accounts[j].bank.ID })
+
+	return accounts
+}
+
+// label returns the label of the monetary account as it appears in payments.
+func (st *store) label(acc *account) model.LabelMonetaryAccount {
+	l := model.LabelMonetaryAccount{Country: "NL"}
+	if iban := acc.bank.GetIBANPointer(); iban != nil {
+		l.IBAN = iban.Value
+	}
+
+	if u, ok := st.users[acc.bank.UserID]; ok {
+		l.DisplayName = u.displayName()
+		l.LabelUser = model.LabelUser{
+			UUID:           u.base().PublicUUID,
+			DisplayName:    u.displayName(),
+			Country:        "NL",
+			PublicNickName: u.base().PublicNickName,
+		}
+	}
+
+	return l
+}
+
+func (a *account) addPayment(p model.Payment) {
+	a.payments = append(a.payments, p)
+	sort.SliceStable(a.payments, func(i, j int) bool { return a.payments[i].ID < a.payments[j].ID })
+}
+
+func (u *user) base() *model.BaseUser {
+	if u.person != nil {
+		return &u.person.BaseUser
+	}
+
+	return &u.company.BaseUser
+}
+
+func (u *user) displayName() string {
+	return u.base().DisplayName
+}
+
+// wrapped returns the user wrapped in an object with its type as key, as bunq returns users.
+func (u *user) wrapped() map[string]interface{} {
+	if u.person != nil {
+		return map[string]interface{}{model.UserTypePerson: u.person}
+	}
+
+	return map[string]interface{}{model.UserTypeCompany: u.company}
+}
+
+// newIBAN returns a valid Dutch IBAN of bunq for the account number.
+func newIBAN(number int) string {
+	bban := fmt.Sprintf("BUNQ%010d", 2000000000+number%1000000000)
+
+	// The check digits make the remainder of the rearranged IBAN, with letters as numbers, one.
+	digits := ""
+	for _, c := range bban + "NL00" {
+		if c >= 'A' && c <= 'Z' {
+			digits += fmt.Sprint(c - 'A' + 10)
+		} else {
+			digits += string(c)
+		}
+	}
+	n, _ := new(big.Int).SetString(digits, 10)
+	check := 98 - new(big.Int).Mod(n, big.NewInt(97)).Int64()
+
+	return fmt.Sprintf("NL%02d%s", check, bban)
+}
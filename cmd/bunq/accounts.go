@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"strconv"
+
+	"github.com/d0x7/go-bunq/pagination"
+)
+
+type account struct {
+	ID          int    `json:"id"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	IBAN        string `json:"iban"`
+	Balance     string `json:"balance"`
+	Currency    string `json:"currency"`
+	Status      string `json:"status"`
+}
+
+func runAccounts(a *app, args []string) error {
+	fs := flag.NewFlagSet("accounts", flag.ContinueOnError)
+	all := fs.Bool("all", false, "include cancelled accounts")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	c, err := a.loadClient()
+	if err != nil {
+		return err
+	}
+
+	var accounts []account
+
+	banks, err := c.AccountService.GetAllMonetaryAccountBank(pagination.Count(200))
+	if err != nil {
+		return err
+	}
+	for _, m := range banks.MonetaryAccountBanks() {
+		accounts = append(accounts, account{m.ID, "bank", m.Description, m.GetIBAN(), m.Balance.Value, m.Balance.Currency, m.Status})
+	}
+
+	savings, err := c.AccountService.GetAllMonetaryAccountSaving(pagination.Count(200))
+	if err != nil {
+		return err
+	}
+	for _, m := range savings.MonetaryAccountSavings() {
+		accounts = append(accounts, account{m.ID, "savings", m.Description, m.GetIBAN(), m.Balance.Value, m.Balance.Currency, m.Status})
+	}
+
+	var rows [][]string
+	shown := accounts[:0]
+	for _, acc := range accounts {
+		if !*all && acc.Status != "ACTIVE" {
+			continue
+		}
+		shown = append(shown, acc)
+		rows = append(rows, []string{strconv.Itoa(acc.ID), acc.Type, acc.Description, acc.IBAN, acc.Balance, acc.Currency, acc.Status})
+	}
+
+	return a.printer.print([]string{"ID", "TYPE", "DESCRIPTION", "IBAN", "BALANCE", "CURRENCY", "STATUS"}, rows, shown)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/base64"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func runAttachment(a *app, args []string) error {
+	fs := flag.NewFlagSet("attachment", flag.ContinueOnError)
+	id := fs.String("id", "", "uuid of the public attachment")
+	output := fs.String("o", "", "file to write the attachment to, defaults to stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *id == "" {
+		return errors.New("missing -id")
+	}
+
+	c, err := a.loadClient()
+	if err != nil {
+		return err
+	}
+
+	encoded, err := c.ContentService.GetAttachmentPublic(*id)
+	if err != nil {
+		return err
+	}
+
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("decoding attachment: %w", err)
+	}
+
+	return writeOutput(a.out, *output, func(w io.Writer) error {
+		_, err := w.Write(content)
+		return err
+	})
+}
+
+// writeOutput calls write with the given file, or with out if no file is given.
+func writeOutput(out io.Writer, file string, write func(w io.Writer) error) error {
+	if file == "" {
+		return write(out)
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
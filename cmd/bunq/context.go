@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+)
+
+func runContext(a *app, args []string) error {
+	if len(args) == 0 {
+		return errors.New("missing subcommand, expected create or show")
+	}
+
+	switch args[0] {
+	case "create":
+		return runContextCreate(a, args[1:])
+	case "show":
+		return runContextShow(a, args[1:])
+	default:
+		return fmt.Errorf("unknown subcommand %q, expected create or show", args[0])
+	}
+}
+
+func runContextCreate(a *app, args []string) error {
+	fs := flag.NewFlagSet("context create", flag.ContinueOnError)
+	apiKey := fs.String("api-key", os.Getenv("BUNQ_API_KEY"), "the API key to register, defaults to $BUNQ_API_KEY")
+	sandbox := fs.Bool("sandbox", false, "use the sandbox environment")
+	description := fs.String("description", "go-bunq-cli", "device description shown in the app")
+	ips := fs.String("ips", "", "comma separated list of permitted IPs, * for any IP, or empty for the current IP")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *apiKey == "" {
+		return errors.New("missing -api-key")
+	}
+
+	baseURL := bunq.BaseURLProduction
+	if *sandbox {
+		baseURL = bunq.BaseURLSandbox
+	}
+
+	permittedIps := bunq.CurrentIP
+	if *ips == "*" {
+		permittedIps = bunq.WildcardIP
+	} else if *ips != "" {
+		permittedIps = strings.Split(*ips, ",")
+	}
+
+	c, err := bunq.CreateContext(a.ctx, baseURL, *apiKey, *description, permittedIps, a.contextFile)
+	if err != nil {
+		return err
+	}
+	a.client = c
+
+	userID, err := c.GetUserID()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Created API context for user %d in %s\n", userID, a.contextFile)
+
+	return nil
+}
+
+// userTypes maps the user types of bunq to the names shown by "context show".
+var userTypes = map[string]string{
+	model.UserTypePerson:  "person",
+	model.UserTypeCompany: "company",
+	model.UserTypeAPIKey:  "api-key",
+}
+
+// contextInfo is the part of the API context that is shown by "context show".
+type contextInfo struct {
+	File           string `json:"file"`
+	BaseURL        string `json:"base_url"`
+	UserID         int    `json:"user_id"`
+	UserType       string `json:"user_type"`
+	DisplayName    string `json:"display_name"`
+	InstallationID int    `json:"installation_id"`
+	SessionID      int    `json:"session_id"`
+}
+
+func runContextShow(a *app, args []string) error {
+	fs := flag.NewFlagSet("context show", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	raw, err := os.ReadFile(a.contextFile)
+	if err != nil {
+		return err
+	}
+
+	var clientCtx model.ClientContext
+	if err := json.Unmarshal(raw, &clientCtx); err != nil {
+		return fmt.Errorf("parsing %s: %w", a.contextFile, err)
+	}
+
+	info := contextInfo{
+		File:    a.contextFile,
+		BaseURL: clientCtx.BaseURL,
+		UserID:  clientCtx.UserID,
+	}
+	if clientCtx.InstallationContext != nil {
+		info.InstallationID = clientCtx.InstallationContext.ID.ID
+	}
+	if s := clientCtx.SessionServerContext; s != nil {
+		info.SessionID = s.ID.ID
+		if u := s.User(); u != nil {
+			info.UserType, info.DisplayName = userTypes[u.UserType()], u.UserDisplayName()
+		}
+	}
+
+	return a.printer.print(
+		[]string{"FILE", "BASE URL", "USER ID", "USER TYPE", "NAME", "INSTALLATION", "SESSION"},
+		[][]string{{
+			info.File,
+			info.BaseURL,
+			strconv.Itoa(info.UserID),
+			info.UserType,
+			info.DisplayName,
+			strconv.Itoa(info.InstallationID),
+			strconv.Itoa(info.SessionID),
+		}},
+		info,
+	)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/d0x7/go-bunq/export"
+)
+
+func runExport(a *app, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	accountID := fs.Int("account", 0, "id of the monetary account")
+	format := fs.String("format", "camt053", "statement format: camt053, ofx, beancount or ledger")
+	output := fs.String("o", "", "file to write the statement to, defaults to stdout")
+	journalAccount := fs.String("journal-account", "Assets:Bunq", "account used for beancount and ledger journals")
+	var filter paymentFilter
+	filter.register(fs, 0)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *accountID == 0 {
+		return errors.New("missing -account")
+	}
+
+	switch *format {
+	case "camt053", "ofx", "beancount", "ledger":
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	var write func(w io.Writer) error
+
+	c, err := a.loadClient()
+	if err != nil {
+		return err
+	}
+
+	payments, err := fetchPayments(c, *accountID, filter)
+	if err != nil {
+		return err
+	}
+
+	switch *format {
+	case "camt053", "ofx":
+		res, err := c.AccountService.GetMonetaryAccountBank(*accountID)
+		if err != nil {
+			return err
+		}
+		if len(res.Response) == 0 {
+			return fmt.Errorf("monetary account %d not found", *accountID)
+		}
+
+		statement, err := export.NewStatement(res.Response[0].MonetaryAccountBank, payments)
+		if err != nil {
+			return err
+		}
+
+		write = statement.WriteCAMT053
+		if *format == "ofx" {
+			write = statement.WriteOFX
+		}
+	default:
+		journal := export.Journal{Account: *journalAccount}
+
+		write = func(w io.Writer) error { return journal.WriteBeancount(w, payments) }
+		if *format == "ledger" {
+			write = func(w io.Writer) error { return journal.WriteLedger(w, payments) }
+		}
+	}
+
+	return writeOutput(a.out, *output, write)
+}
@@ -0,0 +1,120 @@
+// Command bunq is a command-line client for the bunq API.
+//
+// Usage:
+//
+//	bunq [-context file] [-output table|json|csv] <command> [arguments]
+//
+// Run "bunq help" for a list of commands.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/d0x7/go-bunq/bunq"
+)
+
+// command is a subcommand of the cli.
+type command struct {
+	name  string
+	usage string
+	run   func(app *app, args []string) error
+}
+
+// app holds the global options shared by all commands.
+type app struct {
+	ctx         context.Context
+	contextFile string
+	out         io.Writer
+	printer     printer
+
+	client *bunq.Client
+}
+
+var commands = []command{
+	{"context", "context create|show    create a new API context or inspect an existing one", runContext},
+	{"accounts", "accounts               list all monetary accounts with their balance", runAccounts},
+	{"payments", "payments -account id   list and filter the payments of an account", runPayments},
+	{"pay", "pay -account id        make a payment", runPay},
+	{"draft-pay", "draft-pay -account id  create a draft payment, which has to be accepted in the app", runDraftPay},
+	{"attachment", "attachment -id uuid    download a public attachment", runAttachment},
+	{"export", "export -account id     export a statement of an account", runExport},
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "bunq: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("bunq", flag.ContinueOnError)
+	contextFile := fs.String("context", envOr("BUNQ_CONTEXT", "bunq.json"), "path of the API context file, defaults to $BUNQ_CONTEXT")
+	output := fs.String("output", "table", "output format: table, json or csv")
+	fs.Usage = func() { usage(fs.Output(), fs) }
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	p, err := newPrinter(*output, out)
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 || fs.Arg(0) == "help" {
+		usage(out, fs)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	a := &app{ctx: ctx, contextFile: *contextFile, out: out, printer: p}
+	for _, c := range commands {
+		if c.name == fs.Arg(0) {
+			return c.run(a, fs.Args()[1:])
+		}
+	}
+
+	return fmt.Errorf("unknown command %q, run \"bunq help\" for a list of commands", fs.Arg(0))
+}
+
+func usage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintln(w, "Usage: bunq [flags] <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %s\n", c.usage)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Flags:")
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
+
+// loadClient loads the client from the context file, the first time it is called.
+func (a *app) loadClient() (*bunq.Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	c, err := bunq.LoadContext(a.ctx, a.contextFile)
+	if err != nil {
+		return nil, err
+	}
+	a.client = c
+
+	return c, nil
+}
+
+func envOr(key, fallback string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return fallback
+}
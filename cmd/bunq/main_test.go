@@ -0,0 +1,102 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const clientContext = `{
+	"installation_context": {"Id": {"id": 55277}},
+	"session_server_context": {
+		"Id": {"id": 133912},
+		"UserPerson": {"id": 6084, "display_name": "Barrett"}
+	},
+	"base_url": "https://public-api.sandbox.bunq.com/v1/",
+	"user_id": 6084
+}`
+
+func writeContextFile(t *testing.T) string {
+	file := filepath.Join(t.TempDir(), "bunq.json")
+	require.NoError(t, os.WriteFile(file, []byte(clientContext), 0600))
+
+	return file
+}
+
+func TestContextShow(t *testing.T) {
+	t.Parallel()
+
+	contextFile := writeContextFile(t)
+
+	var out bytes.Buffer
+	require.NoError(t, run([]string{"-context", contextFile, "-output", "json", "context", "show"}, &out))
+
+	var info contextInfo
+	require.NoError(t, json.Unmarshal(out.Bytes(), &info))
+	assert.Equal(t, "person", info.UserType)
+	assert.Equal(t, "Barrett", info.DisplayName)
+	assert.Equal(t, 133912, info.SessionID)
+	assert.Equal(t, 55277, info.InstallationID)
+}
+
+func TestContextShowCSV(t *testing.T) {
+	t.Parallel()
+
+	contextFile := writeContextFile(t)
+
+	var out bytes.Buffer
+	require.NoError(t, run([]string{"-context", contextFile, "-output", "csv", "context", "show"}, &out))
+
+	assert.Equal(t, "FILE,BASE URL,USER ID,USER TYPE,NAME,INSTALLATION,SESSION\n"+
+		contextFile+",https://public-api.sandbox.bunq.com/v1/,6084,person,Barrett,55277,133912\n", out.String())
+}
+
+func TestTablePrinter(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	p, err := newPrinter("table", &out)
+	require.NoError(t, err)
+
+	require.NoError(t, p.print([]string{"ID", "NAME"}, [][]string{{"1", "main"}, {"1234", "savings"}}, nil))
+	assert.Equal(t, "ID    NAME\n1     main\n1234  savings\n", out.String())
+}
+
+func TestUnknownCommandAndFormat(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	assert.Error(t, run([]string{"fly"}, &out))
+	assert.Error(t, run([]string{"-output", "xml", "accounts"}, &out))
+	assert.NoError(t, run([]string{"help"}, &out))
+	assert.Contains(t, out.String(), "draft-pay")
+}
+
+func TestTransferFlags(t *testing.T) {
+	t.Parallel()
+
+	tf := transferFlags{accountID: 1, to: "nl91 abna 0417 1643 00", name: "J. Doe", amount: "12.5", currency: "EUR"}
+	amount, pointer, err := tf.parse()
+	require.NoError(t, err)
+	assert.Equal(t, "12.50", amount.Value)
+	assert.Equal(t, "IBAN", pointer.PType)
+	assert.Equal(t, "NL91ABNA0417164300", pointer.Value)
+
+	tf = transferFlags{accountID: 1, to: "bravo@bunq.com", amount: "1", currency: "EUR"}
+	_, pointer, err = tf.parse()
+	require.NoError(t, err)
+	assert.Equal(t, "EMAIL", pointer.PType)
+
+	tf = transferFlags{accountID: 1, to: "NL91ABNA0417164300", amount: "1"}
+	_, _, err = tf.parse()
+	assert.Error(t, err, "IBANs require a name")
+
+	tf = transferFlags{accountID: 1, to: "bravo@bunq.com", amount: "-1"}
+	_, _, err = tf.parse()
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// printer writes the result of a command.
+// Table and CSV output use the given header and rows, while JSON output encodes v.
+type printer interface {
+	print(header []string, rows [][]string, v interface{}) error
+}
+
+func newPrinter(format string, w io.Writer) (printer, error) {
+	switch format {
+	case "table":
+		return tablePrinter{w}, nil
+	case "json":
+		return jsonPrinter{w}, nil
+	case "csv":
+		return csvPrinter{w}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+type tablePrinter struct {
+	w io.Writer
+}
+
+func (p tablePrinter) print(header []string, rows [][]string, _ interface{}) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+type csvPrinter struct {
+	w io.Writer
+}
+
+func (p csvPrinter) print(header []string, rows [][]string, _ interface{}) error {
+	cw := csv.NewWriter(p.w)
+
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+type jsonPrinter struct {
+	w io.Writer
+}
+
+func (p jsonPrinter) print(_ []string, _ [][]string, v interface{}) error {
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/d0x7/go-bunq/pagination"
+	"github.com/shopspring/decimal"
+)
+
+// paymentFilter selects the payments listed or exported.
+type paymentFilter struct {
+	limit     int
+	since     string
+	until     string
+	search    string
+	direction string
+
+	sinceTime time.Time
+	untilTime time.Time
+}
+
+func (f *paymentFilter) register(fs *flag.FlagSet, defaultLimit int) {
+	fs.IntVar(&f.limit, "limit", defaultLimit, "maximum number of payments, 0 for no limit")
+	fs.StringVar(&f.since, "since", "", "only payments on or after this date (YYYY-MM-DD)")
+	fs.StringVar(&f.until, "until", "", "only payments before this date (YYYY-MM-DD)")
+	fs.StringVar(&f.search, "search", "", "only payments whose description or counterparty contains this text")
+	fs.StringVar(&f.direction, "direction", "", "only incoming (in) or outgoing (out) payments")
+}
+
+// parse validates the flags and parses the dates.
+func (f *paymentFilter) parse() error {
+	if f.direction != "" && f.direction != "in" && f.direction != "out" {
+		return fmt.Errorf("invalid direction %q, expected in or out", f.direction)
+	}
+
+	var err error
+	if f.since != "" {
+		if f.sinceTime, err = time.ParseInLocation(time.DateOnly, f.since, time.UTC); err != nil {
+			return fmt.Errorf("invalid -since %q, expected YYYY-MM-DD", f.since)
+		}
+	}
+	if f.until != "" {
+		if f.untilTime, err = time.ParseInLocation(time.DateOnly, f.until, time.UTC); err != nil {
+			return fmt.Errorf("invalid -until %q, expected YYYY-MM-DD", f.until)
+		}
+	}
+
+	return nil
+}
+
+func (f *paymentFilter) matches(p model.Payment) bool {
+	if !f.untilTime.IsZero() && !p.Created.Before(f.untilTime) {
+		return false
+	}
+
+	switch f.direction {
+	case "in":
+		if !p.IsIncoming() {
+			return false
+		}
+	case "out":
+		if !p.IsOutgoing() {
+			return false
+		}
+	}
+
+	if f.search != "" {
+		search := strings.ToLower(f.search)
+		if !strings.Contains(strings.ToLower(p.Description), search) &&
+			!strings.Contains(strings.ToLower(p.CounterpartyAlias.DisplayName), search) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fetchPayments pages back through the payments of the account, newest first, until the filter is satisfied.
+func fetchPayments(c *bunq.Client, accountID int, f paymentFilter) ([]model.Payment, error) {
+	if err := f.parse(); err != nil {
+		return nil, err
+	}
+
+	var payments []model.Payment
+	params := []model.QueryParam{pagination.Count(200)}
+
+	for {
+		res, err := c.PaymentService.GetAllPayment(accountID, params...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range res.Payments() {
+			if !f.sinceTime.IsZero() && p.Created.Before(f.sinceTime) {
+				return payments, nil
+			}
+			if !f.matches(p) {
+				continue
+			}
+
+			payments = append(payments, p)
+			if f.limit > 0 && len(payments) >= f.limit {
+				return payments, nil
+			}
+		}
+
+		if !res.Pagination.HasPrevious() {
+			return payments, nil
+		}
+		params = []model.QueryParam{res.Pagination.PreviousPage()}
+	}
+}
+
+func runPayments(a *app, args []string) error {
+	fs := flag.NewFlagSet("payments", flag.ContinueOnError)
+	accountID := fs.Int("account", 0, "id of the monetary account")
+	var filter paymentFilter
+	filter.register(fs, 50)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *accountID == 0 {
+		return errors.New("missing -account")
+	}
+
+	c, err := a.loadClient()
+	if err != nil {
+		return err
+	}
+
+	payments, err := fetchPayments(c, *accountID, filter)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(payments))
+	for _, p := range payments {
+		counterparty := p.CounterpartyAlias.IBAN
+		if counterparty == "" {
+			counterparty = p.CounterpartyAlias.DisplayName
+		}
+
+		rows = append(rows, []string{
+			strconv.Itoa(p.ID),
+			p.Created.String(),
+			p.Amount.Value,
+			p.Amount.Currency,
+			p.CounterpartyAlias.DisplayName,
+			counterparty,
+			p.Description,
+		})
+	}
+
+	return a.printer.print([]string{"ID", "CREATED", "AMOUNT", "CURRENCY", "COUNTERPARTY", "ACCOUNT", "DESCRIPTION"}, rows, payments)
+}
+
+// transferFlags are the flags shared by pay and draft-pay.
+type transferFlags struct {
+	accountID   int
+	to          string
+	name        string
+	amount      string
+	currency    string
+	description string
+}
+
+func (t *transferFlags) register(fs *flag.FlagSet) {
+	fs.IntVar(&t.accountID, "account", 0, "id of the monetary account to pay from")
+	fs.StringVar(&t.to, "to", "", "IBAN, email address or phone number of the counterparty")
+	fs.StringVar(&t.name, "name", "", "name of the counterparty, required for IBANs")
+	fs.StringVar(&t.amount, "amount", "", "amount to pay, e.g. 12.50")
+	fs.StringVar(&t.currency, "currency", "EUR", "currency of the amount")
+	fs.StringVar(&t.description, "description", "", "description of the payment")
+}
+
+func (t *transferFlags) parse() (model.Amount, model.Pointer, error) {
+	if t.accountID == 0 {
+		return model.Amount{}, model.Pointer{}, errors.New("missing -account")
+	}
+	if t.to == "" {
+		return model.Amount{}, model.Pointer{}, errors.New("missing -to")
+	}
+
+	value, err := decimal.NewFromString(t.amount)
+	if err != nil || !value.IsPositive() {
+		return model.Amount{}, model.Pointer{}, fmt.Errorf("invalid amount %q", t.amount)
+	}
+	amount, err := model.NewAmount(value, t.currency)
+	if err != nil {
+		return model.Amount{}, model.Pointer{}, err
+	}
+
+	var pointer model.Pointer
+	switch {
+	case strings.Contains(t.to, "@"):
+		pointer, err = model.EmailPointer(t.to)
+	case strings.HasPrefix(t.to, "+"):
+		pointer, err = model.PhoneNumberPointer(t.to)
+	default:
+		if t.name == "" {
+			return model.Amount{}, model.Pointer{}, errors.New("missing -name, which is required for IBANs")
+		}
+		pointer, err = model.IBANPointer(t.to, t.name)
+	}
+	if err != nil {
+		return model.Amount{}, model.Pointer{}, err
+	}
+	if t.name != "" {
+		pointer.Name = &t.name
+	}
+
+	return amount, pointer, nil
+}
+
+func runPay(a *app, args []string) error {
+	fs := flag.NewFlagSet("pay", flag.ContinueOnError)
+	var t transferFlags
+	t.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	amount, pointer, err := t.parse()
+	if err != nil {
+		return err
+	}
+
+	c, err := a.loadClient()
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PaymentService.CreatePayment(t.accountID, model.PaymentCreate{
+		Amount:            amount,
+		CounterpartyAlias: pointer,
+		Description:       t.description,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Created payment %d\n", res.ID())
+
+	return nil
+}
+
+func runDraftPay(a *app, args []string) error {
+	fs := flag.NewFlagSet("draft-pay", flag.ContinueOnError)
+	var t transferFlags
+	t.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	amount, pointer, err := t.parse()
+	if err != nil {
+		return err
+	}
+
+	c, err := a.loadClient()
+	if err != nil {
+		return err
+	}
+
+	res, err := c.PaymentService.CreateDraftPayment(t.accountID, model.RequestCreateDraftPayment{
+		Entries: []model.DraftPaymentEntryCreate{
+			{
+				Amount:            amount,
+				CounterpartyAlias: pointer,
+				Description:       t.description,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "Created draft payment %d, accept it in the bunq app\n", res.ID())
+
+	return nil
+}
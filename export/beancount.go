@@ -0,0 +1,60 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+const beancountDateLayout = "2006-01-02"
+
+var beancountEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// WriteBeancount writes the payments as Beancount transactions.
+// Every transaction carries the bunq payment id and merchant reference as metadata, so imports can be de-duplicated.
+// After the last payment of each day a balance assertion is written,
+// which is dated on the next day, as Beancount checks balances at the beginning of the day.
+func (j *Journal) WriteBeancount(w io.Writer, payments []model.Payment) error {
+	entries, err := j.entries(payments)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+
+	for _, e := range entries {
+		p := e.payment
+
+		fmt.Fprintf(bw, "%s * \"%s\" \"%s\"\n", e.date.Format(beancountDateLayout), beancountString(payee(p)), beancountString(p.Description))
+		fmt.Fprintf(bw, "  bunq_payment_id: \"%d\"\n", p.ID)
+		if p.MerchantReference != "" {
+			fmt.Fprintf(bw, "  merchant_reference: \"%s\"\n", beancountString(p.MerchantReference))
+		}
+		fmt.Fprintf(bw, "  %s  %s %s\n", j.Account, formatAmount(p.Amount.Decimal), p.Amount.Currency)
+		fmt.Fprintf(bw, "  %s  %s %s\n", e.counterAccount, formatAmount(p.Amount.Decimal.Neg()), p.Amount.Currency)
+		fmt.Fprintln(bw)
+
+		if e.assertBalance {
+			fmt.Fprintf(bw, "%s balance %s  %s %s\n\n",
+				e.date.AddDate(0, 0, 1).Format(beancountDateLayout),
+				j.Account,
+				formatAmount(p.BalanceAfterMutation.Decimal),
+				p.BalanceAfterMutation.Currency,
+			)
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return errors.Wrap(err, "export: could not write beancount journal")
+	}
+
+	return nil
+}
+
+func beancountString(value string) string {
+	return beancountEscaper.Replace(singleLine(value))
+}
@@ -0,0 +1,252 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+
+	camtCredit = "CRDT"
+	camtDebit  = "DBIT"
+
+	camtDateLayout     = "2006-01-02"
+	camtDateTimeLayout = "2006-01-02T15:04:05Z07:00"
+)
+
+type camtDocument struct {
+	XMLName xml.Name          `xml:"Document"`
+	Xmlns   string            `xml:"xmlns,attr"`
+	Stmt    camtBkToCstmrStmt `xml:"BkToCstmrStmt"`
+}
+
+type camtBkToCstmrStmt struct {
+	GrpHdr camtGrpHdr    `xml:"GrpHdr"`
+	Stmt   camtStatement `xml:"Stmt"`
+}
+
+type camtGrpHdr struct {
+	MsgID   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+}
+
+type camtStatement struct {
+	ID      string        `xml:"Id"`
+	CreDtTm string        `xml:"CreDtTm"`
+	FrToDt  camtFrToDt    `xml:"FrToDt"`
+	Acct    camtAccount   `xml:"Acct"`
+	Bal     []camtBalance `xml:"Bal"`
+	Ntry    []camtEntry   `xml:"Ntry"`
+}
+
+type camtFrToDt struct {
+	FrDtTm string `xml:"FrDtTm"`
+	ToDtTm string `xml:"ToDtTm"`
+}
+
+type camtAccount struct {
+	IBAN string `xml:"Id>IBAN"`
+	Ccy  string `xml:"Ccy"`
+	Nm   string `xml:"Nm,omitempty"`
+	BIC  string `xml:"Svcr>FinInstnId>BIC"`
+}
+
+type camtAmount struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type camtBalance struct {
+	Code      string     `xml:"Tp>CdOrPrtry>Cd"`
+	Amt       camtAmount `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	Dt        string     `xml:"Dt>Dt"`
+}
+
+type camtEntry struct {
+	NtryRef     string        `xml:"NtryRef"`
+	Amt         camtAmount    `xml:"Amt"`
+	CdtDbtInd   string        `xml:"CdtDbtInd"`
+	Sts         string        `xml:"Sts"`
+	BookgDt     string        `xml:"BookgDt>DtTm"`
+	ValDt       string        `xml:"ValDt>Dt"`
+	AcctSvcrRef string        `xml:"AcctSvcrRef"`
+	BkTxCd      camtBkTxCd    `xml:"BkTxCd"`
+	TxDtls      camtTxDetails `xml:"NtryDtls>TxDtls"`
+}
+
+type camtBkTxCd struct {
+	Cd   string `xml:"Prtry>Cd"`
+	Issr string `xml:"Prtry>Issr"`
+}
+
+type camtTxDetails struct {
+	AcctSvcrRef string             `xml:"Refs>AcctSvcrRef"`
+	EndToEndID  string             `xml:"Refs>EndToEndId"`
+	RltdPties   camtRelatedParties `xml:"RltdPties"`
+	RltdAgts    *camtRelatedAgents `xml:"RltdAgts,omitempty"`
+	Ustrd       string             `xml:"RmtInf>Ustrd,omitempty"`
+}
+
+type camtRelatedParties struct {
+	Dbtr     *camtParty     `xml:"Dbtr,omitempty"`
+	DbtrAcct *camtPartyAcct `xml:"DbtrAcct,omitempty"`
+	Cdtr     *camtParty     `xml:"Cdtr,omitempty"`
+	CdtrAcct *camtPartyAcct `xml:"CdtrAcct,omitempty"`
+}
+
+type camtParty struct {
+	Nm string `xml:"Nm"`
+}
+
+type camtPartyAcct struct {
+	IBAN string `xml:"Id>IBAN"`
+}
+
+type camtRelatedAgents struct {
+	DbtrAgt *camtAgent `xml:"DbtrAgt,omitempty"`
+	CdtrAgt *camtAgent `xml:"CdtrAgt,omitempty"`
+}
+
+type camtAgent struct {
+	BIC string `xml:"FinInstnId>BIC"`
+}
+
+// WriteCAMT053 writes the statement as an ISO 20022 camt.053.001.02 bank to customer statement.
+func (s *Statement) WriteCAMT053(w io.Writer) error {
+	iban := s.Account.GetIBAN()
+	if iban == "" {
+		return errors.New("export: account has no IBAN")
+	}
+
+	now := time.Now().UTC()
+	statementID := fmt.Sprintf("%d-%s", s.Account.ID, s.To.Format("20060102"))
+
+	doc := camtDocument{
+		Xmlns: camt053Namespace,
+		Stmt: camtBkToCstmrStmt{
+			GrpHdr: camtGrpHdr{
+				MsgID:   fmt.Sprintf("%s-%d", statementID, now.Unix()),
+				CreDtTm: now.Format(camtDateTimeLayout),
+			},
+			Stmt: camtStatement{
+				ID:      statementID,
+				CreDtTm: now.Format(camtDateTimeLayout),
+				FrToDt: camtFrToDt{
+					FrDtTm: s.From.Format(camtDateTimeLayout),
+					ToDtTm: s.To.Format(camtDateTimeLayout),
+				},
+				Acct: camtAccount{
+					IBAN: iban,
+					Ccy:  s.Account.Currency,
+					Nm:   s.Account.Description,
+					BIC:  bunqBIC,
+				},
+				Bal: []camtBalance{
+					s.camtBalance("OPBD", s.OpeningBalance, s.From),
+					s.camtBalance("CLBD", s.ClosingBalance, s.To),
+				},
+			},
+		},
+	}
+
+	for _, p := range s.Payments {
+		entry, err := camtPaymentEntry(p)
+		if err != nil {
+			return err
+		}
+		doc.Stmt.Stmt.Ntry = append(doc.Stmt.Stmt.Ntry, entry)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return errors.Wrap(err, "export: could not write xml header")
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return errors.Wrap(err, "export: could not encode camt.053 document")
+	}
+
+	return nil
+}
+
+func (s *Statement) camtBalance(code string, balance decimal.Decimal, date time.Time) camtBalance {
+	return camtBalance{
+		Code:      code,
+		Amt:       camtAmount{Ccy: s.Account.Currency, Value: formatAmount(balance.Abs())},
+		CdtDbtInd: camtIndicator(balance),
+		Dt:        date.Format(camtDateLayout),
+	}
+}
+
+func camtPaymentEntry(p model.Payment) (camtEntry, error) {
+	created, err := createdAt(p)
+	if err != nil {
+		return camtEntry{}, err
+	}
+
+	id := strconv.Itoa(p.ID)
+	endToEndID := p.MerchantReference
+	if endToEndID == "" {
+		endToEndID = "NOTPROVIDED"
+	}
+
+	entry := camtEntry{
+		NtryRef:     id,
+		Amt:         camtAmount{Ccy: p.Amount.Currency, Value: formatAmount(p.Amount.Decimal.Abs())},
+		CdtDbtInd:   camtIndicator(p.Amount.Decimal),
+		Sts:         "BOOK",
+		BookgDt:     created.Format(camtDateTimeLayout),
+		ValDt:       created.Format(camtDateLayout),
+		AcctSvcrRef: id,
+		BkTxCd: camtBkTxCd{
+			Cd:   p.Type,
+			Issr: "bunq",
+		},
+		TxDtls: camtTxDetails{
+			AcctSvcrRef: id,
+			EndToEndID:  endToEndID,
+			Ustrd:       p.Description,
+		},
+	}
+
+	counterparty := &camtParty{Nm: p.CounterpartyAlias.DisplayName}
+	var counterpartyAcct *camtPartyAcct
+	if p.CounterpartyAlias.IBAN != "" {
+		counterpartyAcct = &camtPartyAcct{IBAN: p.CounterpartyAlias.IBAN}
+	}
+	var counterpartyAgent *camtAgent
+	if p.CounterpartyAlias.SwiftBic != "" {
+		counterpartyAgent = &camtAgent{BIC: p.CounterpartyAlias.SwiftBic}
+	}
+
+	if p.IsIncoming() {
+		entry.TxDtls.RltdPties = camtRelatedParties{Dbtr: counterparty, DbtrAcct: counterpartyAcct}
+		if counterpartyAgent != nil {
+			entry.TxDtls.RltdAgts = &camtRelatedAgents{DbtrAgt: counterpartyAgent}
+		}
+	} else {
+		entry.TxDtls.RltdPties = camtRelatedParties{Cdtr: counterparty, CdtrAcct: counterpartyAcct}
+		if counterpartyAgent != nil {
+			entry.TxDtls.RltdAgts = &camtRelatedAgents{CdtrAgt: counterpartyAgent}
+		}
+	}
+
+	return entry, nil
+}
+
+func camtIndicator(d decimal.Decimal) string {
+	if d.IsNegative() {
+		return camtDebit
+	}
+	return camtCredit
+}
@@ -0,0 +1,156 @@
+package export
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultExpenseAccount is used for outgoing payments that are not matched by any rule.
+	DefaultExpenseAccount = "Expenses:Uncategorized"
+	// DefaultIncomeAccount is used for incoming payments that are not matched by any rule.
+	DefaultIncomeAccount = "Income:Uncategorized"
+
+	paymentTypeMastercard = "MASTERCARD"
+)
+
+// Rule maps payments to a counter account of a journal.
+// All criteria that are set must match for the rule to apply, a rule without any criteria never matches.
+type Rule struct {
+	// Account is the account the matching payments are booked against, e.g. "Expenses:Groceries".
+	Account string
+	// CounterpartyIBAN matches the IBAN of the counterparty, ignoring spaces and case.
+	CounterpartyIBAN string
+	// Description matches the description of the payment.
+	Description *regexp.Regexp
+	// Merchant matches card payments whose merchant name contains the given value, ignoring case.
+	Merchant string
+}
+
+// Matches returns true if the rule applies to the given payment.
+func (r *Rule) Matches(p model.Payment) bool {
+	if r.CounterpartyIBAN == "" && r.Description == nil && r.Merchant == "" {
+		return false
+	}
+
+	if r.CounterpartyIBAN != "" && normalizeIBAN(r.CounterpartyIBAN) != normalizeIBAN(p.CounterpartyAlias.IBAN) {
+		return false
+	}
+
+	if r.Description != nil && !r.Description.MatchString(p.Description) {
+		return false
+	}
+
+	if r.Merchant != "" {
+		if p.Type != paymentTypeMastercard {
+			return false
+		}
+		if !strings.Contains(strings.ToLower(p.CounterpartyAlias.DisplayName), strings.ToLower(r.Merchant)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Journal converts payments of a single monetary account into plain-text accounting journal entries.
+type Journal struct {
+	// Account is the account representing the bunq monetary account, e.g. "Assets:Bunq:Checking".
+	Account string
+	// Rules are evaluated in order, the first matching rule determines the counter account.
+	Rules []Rule
+	// ExpenseAccount is used for unmatched outgoing payments, defaults to DefaultExpenseAccount.
+	ExpenseAccount string
+	// IncomeAccount is used for unmatched incoming payments, defaults to DefaultIncomeAccount.
+	IncomeAccount string
+}
+
+// journalEntry is a payment prepared for writing, independent of the journal format.
+type journalEntry struct {
+	payment        model.Payment
+	date           time.Time
+	counterAccount string
+	// assertBalance is true for the last payment of the day.
+	assertBalance bool
+}
+
+// CounterAccount returns the account the given payment is booked against.
+func (j *Journal) CounterAccount(p model.Payment) string {
+	for i := range j.Rules {
+		if j.Rules[i].Matches(p) {
+			return j.Rules[i].Account
+		}
+	}
+
+	if p.IsIncoming() {
+		if j.IncomeAccount != "" {
+			return j.IncomeAccount
+		}
+		return DefaultIncomeAccount
+	}
+
+	if j.ExpenseAccount != "" {
+		return j.ExpenseAccount
+	}
+	return DefaultExpenseAccount
+}
+
+func (j *Journal) entries(payments []model.Payment) ([]journalEntry, error) {
+	if j.Account == "" {
+		return nil, errors.New("export: journal has no account")
+	}
+
+	sorted := sortPayments(payments)
+	entries := make([]journalEntry, 0, len(sorted))
+
+	for _, p := range sorted {
+		date, err := createdAt(p)
+		if err != nil {
+			return nil, err
+		}
+		if p.BalanceAfterMutation.Value == "" {
+			return nil, errors.Wrapf(ErrMissingBalance, "payment %d", p.ID)
+		}
+
+		if n := len(entries); n > 0 && sameDay(entries[n-1].date, date) {
+			entries[n-1].assertBalance = false
+		}
+
+		entries = append(entries, journalEntry{
+			payment:        p,
+			date:           date,
+			counterAccount: j.CounterAccount(p),
+			assertBalance:  true,
+		})
+	}
+
+	return entries, nil
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+
+	return ay == by && am == bm && ad == bd
+}
+
+func normalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+}
+
+// payee returns the name shown as payee of the payment.
+func payee(p model.Payment) string {
+	if p.CounterpartyAlias.DisplayName != "" {
+		return p.CounterpartyAlias.DisplayName
+	}
+	return p.CounterpartyAlias.IBAN
+}
+
+// singleLine replaces line breaks, which are not allowed within journal headers and metadata.
+func singleLine(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
@@ -0,0 +1,127 @@
+package export
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createJournal() *Journal {
+	return &Journal{
+		Account: "Assets:Bunq:Checking",
+		Rules: []Rule{
+			{Account: "Expenses:Rent", Description: regexp.MustCompile(`(?i)^rent`)},
+			{Account: "Income:Salary", CounterpartyIBAN: "nl65 bunq 9900 0001 88", Description: regexp.MustCompile(`salary`)},
+			{Account: "Expenses:Groceries", Merchant: "shop"},
+		},
+	}
+}
+
+func TestRule_Matches(t *testing.T) {
+	t.Parallel()
+
+	p := createPayment(t, 1, "2024-01-01 12:00:00.000000", "-10.00", "10.00", "SHOP AMSTERDAM", "card payment")
+
+	merchant := Rule{Account: "Expenses:Groceries", Merchant: "shop"}
+	assert.False(t, merchant.Matches(p), "merchant rules only apply to card payments")
+
+	p.Type = paymentTypeMastercard
+	assert.True(t, merchant.Matches(p))
+
+	assert.False(t, (&Rule{Account: "Expenses:Anything"}).Matches(p))
+	assert.False(t, (&Rule{Account: "Expenses:Other", CounterpartyIBAN: "NL85BUNQ9900100611"}).Matches(p))
+}
+
+func TestJournal_CounterAccount(t *testing.T) {
+	t.Parallel()
+
+	j := createJournal()
+	payments := createPayments(t)
+
+	assert.Equal(t, "Expenses:Uncategorized", j.CounterAccount(payments[0]))
+	assert.Equal(t, "Expenses:Rent", j.CounterAccount(payments[1]))
+	assert.Equal(t, "Income:Salary", j.CounterAccount(payments[2]))
+
+	j.ExpenseAccount = "Expenses:Unknown"
+	assert.Equal(t, "Expenses:Unknown", j.CounterAccount(payments[0]))
+}
+
+func TestJournal_WriteBeancount(t *testing.T) {
+	t.Parallel()
+
+	payments := createPayments(t)
+	payments = append(payments, createPayment(t, 4, "2024-01-03 18:00:00.000000", "-4.50", "70.00", `Bar "The Tap"`, "drinks"))
+	payments[3].MerchantReference = "ref-4"
+
+	var buf bytes.Buffer
+	require.NoError(t, createJournal().WriteBeancount(&buf, payments))
+
+	expected := `2024-01-01 * "S. Daddy" "salary"
+  bunq_payment_id: "1"
+  Assets:Bunq:Checking  100.00 EUR
+  Income:Salary  -100.00 EUR
+
+2024-01-02 balance Assets:Bunq:Checking  130.00 EUR
+
+2024-01-02 * "Landlord" "rent"
+  bunq_payment_id: "2"
+  Assets:Bunq:Checking  -30.00 EUR
+  Expenses:Rent  30.00 EUR
+
+2024-01-03 balance Assets:Bunq:Checking  100.00 EUR
+
+2024-01-03 * "Shop & Co" "groceries"
+  bunq_payment_id: "3"
+  Assets:Bunq:Checking  -25.50 EUR
+  Expenses:Uncategorized  25.50 EUR
+
+2024-01-03 * "Bar \"The Tap\"" "drinks"
+  bunq_payment_id: "4"
+  merchant_reference: "ref-4"
+  Assets:Bunq:Checking  -4.50 EUR
+  Expenses:Uncategorized  4.50 EUR
+
+2024-01-04 balance Assets:Bunq:Checking  70.00 EUR
+
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestJournal_WriteLedger(t *testing.T) {
+	t.Parallel()
+
+	payments := createPayments(t)
+	payments[0].MerchantReference = "ref-3"
+
+	var buf bytes.Buffer
+	require.NoError(t, createJournal().WriteLedger(&buf, payments))
+
+	expected := `2024-01-01 * (1) S. Daddy | salary
+    ; bunq_payment_id: 1
+    Assets:Bunq:Checking  100.00 EUR = 130.00 EUR
+    Income:Salary
+
+2024-01-02 * (2) Landlord | rent
+    ; bunq_payment_id: 2
+    Assets:Bunq:Checking  -30.00 EUR = 100.00 EUR
+    Expenses:Rent
+
+2024-01-03 * (3) Shop & Co | groceries
+    ; bunq_payment_id: 3
+    ; merchant_reference: ref-3
+    Assets:Bunq:Checking  -25.50 EUR = 74.50 EUR
+    Expenses:Uncategorized
+
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestJournal_NoAccount(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	assert.Error(t, (&Journal{}).WriteLedger(&buf, createPayments(t)))
+}
@@ -0,0 +1,53 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+const ledgerDateLayout = "2006-01-02"
+
+// WriteLedger writes the payments as Ledger journal entries, which can be read by both Ledger and hledger.
+// The bunq payment id is used as transaction code and, together with the merchant reference, written as tags.
+// Every posting on the journal account asserts the BalanceAfterMutation of the payment.
+func (j *Journal) WriteLedger(w io.Writer, payments []model.Payment) error {
+	entries, err := j.entries(payments)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+
+	for _, e := range entries {
+		p := e.payment
+
+		fmt.Fprintf(bw, "%s * (%d) %s", e.date.Format(ledgerDateLayout), p.ID, singleLine(payee(p)))
+		if p.Description != "" {
+			fmt.Fprintf(bw, " | %s", singleLine(p.Description))
+		}
+		fmt.Fprintln(bw)
+
+		fmt.Fprintf(bw, "    ; bunq_payment_id: %d\n", p.ID)
+		if p.MerchantReference != "" {
+			fmt.Fprintf(bw, "    ; merchant_reference: %s\n", singleLine(p.MerchantReference))
+		}
+		fmt.Fprintf(bw, "    %s  %s %s = %s %s\n",
+			j.Account,
+			formatAmount(p.Amount.Decimal),
+			p.Amount.Currency,
+			formatAmount(p.BalanceAfterMutation.Decimal),
+			p.BalanceAfterMutation.Currency,
+		)
+		fmt.Fprintf(bw, "    %s\n\n", e.counterAccount)
+	}
+
+	if err := bw.Flush(); err != nil {
+		return errors.Wrap(err, "export: could not write ledger journal")
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package export
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	ofxHeader         = `<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n"
+	ofxDateTimeLayout = "20060102150405.000[0:MST]"
+
+	// ofxMaxNameLength is the maximum length of the NAME element of a transaction.
+	ofxMaxNameLength = 32
+	// ofxMaxMemoLength is the maximum length of the MEMO element of a transaction.
+	ofxMaxMemoLength = 255
+)
+
+type ofxDocument struct {
+	XMLName xml.Name     `xml:"OFX"`
+	SignOn  ofxSignOn    `xml:"SIGNONMSGSRSV1>SONRS"`
+	Bank    ofxStmtTrnRs `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxSignOn struct {
+	Status   ofxStatus `xml:"STATUS"`
+	DTServer string    `xml:"DTSERVER"`
+	Language string    `xml:"LANGUAGE"`
+}
+
+type ofxStmtTrnRs struct {
+	TrnUID string    `xml:"TRNUID"`
+	Status ofxStatus `xml:"STATUS"`
+	StmtRs ofxStmtRs `xml:"STMTRS"`
+}
+
+type ofxStmtRs struct {
+	CurDef       string         `xml:"CURDEF"`
+	BankAcctFrom ofxBankAccount `xml:"BANKACCTFROM"`
+	TranList     ofxTranList    `xml:"BANKTRANLIST"`
+	LedgerBal    ofxLedgerBal   `xml:"LEDGERBAL"`
+	BalList      []ofxBal       `xml:"BALLIST>BAL"`
+}
+
+type ofxBankAccount struct {
+	BankID   string `xml:"BANKID"`
+	AcctID   string `xml:"ACCTID"`
+	AcctType string `xml:"ACCTTYPE"`
+}
+
+type ofxTranList struct {
+	DTStart string       `xml:"DTSTART"`
+	DTEnd   string       `xml:"DTEND"`
+	Trn     []ofxStmtTrn `xml:"STMTTRN"`
+}
+
+type ofxStmtTrn struct {
+	TrnType    string          `xml:"TRNTYPE"`
+	DTPosted   string          `xml:"DTPOSTED"`
+	TrnAmt     string          `xml:"TRNAMT"`
+	FITID      string          `xml:"FITID"`
+	Name       string          `xml:"NAME,omitempty"`
+	BankAcctTo *ofxBankAccount `xml:"BANKACCTTO,omitempty"`
+	Memo       string          `xml:"MEMO,omitempty"`
+}
+
+type ofxLedgerBal struct {
+	BalAmt string `xml:"BALAMT"`
+	DTAsOf string `xml:"DTASOF"`
+}
+
+type ofxBal struct {
+	Name    string `xml:"NAME"`
+	Desc    string `xml:"DESC"`
+	BalType string `xml:"BALTYPE"`
+	Value   string `xml:"VALUE"`
+	DTAsOf  string `xml:"DTASOF"`
+}
+
+// WriteOFX writes the statement as an OFX 2.2 bank statement response.
+// The closing balance is written as the ledger balance, the opening balance as an additional balance.
+func (s *Statement) WriteOFX(w io.Writer) error {
+	iban := s.Account.GetIBAN()
+	if iban == "" {
+		return errors.New("export: account has no IBAN")
+	}
+
+	ok := ofxStatus{Code: 0, Severity: "INFO"}
+	doc := ofxDocument{
+		SignOn: ofxSignOn{
+			Status:   ok,
+			DTServer: formatOFXTime(time.Now()),
+			Language: "ENG",
+		},
+		Bank: ofxStmtTrnRs{
+			TrnUID: "0",
+			Status: ok,
+			StmtRs: ofxStmtRs{
+				CurDef: s.Account.Currency,
+				BankAcctFrom: ofxBankAccount{
+					BankID:   bunqBIC,
+					AcctID:   iban,
+					AcctType: "CHECKING",
+				},
+				TranList: ofxTranList{
+					DTStart: formatOFXTime(s.From),
+					DTEnd:   formatOFXTime(s.To),
+				},
+				LedgerBal: ofxLedgerBal{
+					BalAmt: formatAmount(s.ClosingBalance),
+					DTAsOf: formatOFXTime(s.To),
+				},
+				BalList: []ofxBal{
+					{
+						Name:    "Opening balance",
+						Desc:    "Balance before the first transaction",
+						BalType: "DOLLAR",
+						Value:   formatAmount(s.OpeningBalance),
+						DTAsOf:  formatOFXTime(s.From),
+					},
+				},
+			},
+		},
+	}
+
+	for _, p := range s.Payments {
+		trn, err := ofxPaymentTransaction(p)
+		if err != nil {
+			return err
+		}
+		doc.Bank.StmtRs.TranList.Trn = append(doc.Bank.StmtRs.TranList.Trn, trn)
+	}
+
+	if _, err := io.WriteString(w, xml.Header+ofxHeader); err != nil {
+		return errors.Wrap(err, "export: could not write ofx header")
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return errors.Wrap(err, "export: could not encode ofx document")
+	}
+
+	return nil
+}
+
+func ofxPaymentTransaction(p model.Payment) (ofxStmtTrn, error) {
+	created, err := createdAt(p)
+	if err != nil {
+		return ofxStmtTrn{}, err
+	}
+
+	trnType := "CREDIT"
+	if p.IsOutgoing() {
+		trnType = "DEBIT"
+	}
+
+	trn := ofxStmtTrn{
+		TrnType:  trnType,
+		DTPosted: formatOFXTime(created),
+		TrnAmt:   formatAmount(p.Amount.Decimal),
+		FITID:    strconv.Itoa(p.ID),
+		Name:     truncate(p.CounterpartyAlias.DisplayName, ofxMaxNameLength),
+		Memo:     truncate(p.Description, ofxMaxMemoLength),
+	}
+
+	if p.CounterpartyAlias.IBAN != "" && p.CounterpartyAlias.SwiftBic != "" {
+		trn.BankAcctTo = &ofxBankAccount{
+			BankID:   p.CounterpartyAlias.SwiftBic,
+			AcctID:   p.CounterpartyAlias.IBAN,
+			AcctType: "CHECKING",
+		}
+	}
+
+	return trn, nil
+}
+
+func formatOFXTime(t time.Time) string {
+	return t.UTC().Format(ofxDateTimeLayout)
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+	return string(runes[:length])
+}
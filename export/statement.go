@@ -0,0 +1,102 @@
+// Package export converts bunq payments into statement formats understood by accounting software.
+package export
+
+import (
+	"sort"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// bunqBIC is the BIC of bunq, used as the servicer of all exported accounts.
+	bunqBIC = "BUNQNL2A"
+)
+
+var (
+	ErrMissingBalance   = errors.New("export: payment has no balance after mutation")
+	ErrCurrencyMismatch = errors.New("export: payment currency does not match the account currency")
+	ErrMissingCreated   = errors.New("export: payment has no creation time")
+)
+
+// Statement is an account statement for a single monetary account,
+// built from the payments made on that account.
+type Statement struct {
+	Account model.MonetaryAccountBank
+	// Payments are the payments of the statement, sorted from oldest to newest.
+	Payments []model.Payment
+
+	OpeningBalance decimal.Decimal
+	ClosingBalance decimal.Decimal
+	From           time.Time
+	To             time.Time
+}
+
+// NewStatement creates a new statement for the given account and payments.
+// The payments may be passed in any order, e.g. straight from PaymentService.GetAllPayment.
+// The opening and closing balances are derived from the BalanceAfterMutation of the oldest and newest payment.
+// Without any payments, both balances equal the current account balance.
+func NewStatement(account model.MonetaryAccountBank, payments []model.Payment) (*Statement, error) {
+	s := &Statement{
+		Account:  account,
+		Payments: sortPayments(payments),
+	}
+
+	for _, p := range s.Payments {
+		if p.Amount.Currency != account.Currency {
+			return nil, errors.Wrapf(ErrCurrencyMismatch, "payment %d is in %s", p.ID, p.Amount.Currency)
+		}
+		if p.BalanceAfterMutation.Value == "" {
+			return nil, errors.Wrapf(ErrMissingBalance, "payment %d", p.ID)
+		}
+	}
+
+	if len(s.Payments) == 0 {
+		s.OpeningBalance = account.Balance.Decimal
+		s.ClosingBalance = account.Balance.Decimal
+		s.From = time.Now().UTC()
+		s.To = s.From
+		return s, nil
+	}
+
+	oldest, newest := s.Payments[0], s.Payments[len(s.Payments)-1]
+	s.OpeningBalance = oldest.BalanceAfterMutation.Decimal.Sub(oldest.Amount.Decimal)
+	s.ClosingBalance = newest.BalanceAfterMutation.Decimal
+
+	var err error
+	if s.From, err = createdAt(oldest); err != nil {
+		return nil, err
+	}
+	if s.To, err = createdAt(newest); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// sortPayments returns a copy of the payments, sorted from oldest to newest.
+func sortPayments(payments []model.Payment) []model.Payment {
+	sorted := make([]model.Payment, len(payments))
+	copy(sorted, payments)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	return sorted
+}
+
+// createdAt returns the creation time of the payment, which all formats need.
+func createdAt(p model.Payment) (time.Time, error) {
+	if p.Created.IsZero() {
+		return time.Time{}, errors.Wrapf(ErrMissingCreated, "payment %d", p.ID)
+	}
+
+	return p.Created.Time, nil
+}
+
+func formatAmount(d decimal.Decimal) string {
+	return d.StringFixed(2)
+}
@@ -0,0 +1,138 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadAccount(t *testing.T) model.MonetaryAccountBank {
+	var res model.ResponseMonetaryAccountBankGet
+	loadFixture(t, "../testdata/bunq/monetary_account_bank_listing_response.json", &res)
+
+	return res.Response[0].MonetaryAccountBank
+}
+
+func loadFixture(t *testing.T, path string, obj interface{}) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	require.NoError(t, json.NewDecoder(file).Decode(obj))
+}
+
+func createPayment(t *testing.T, id int, created, amount, balance, counterparty, description string) model.Payment {
+	var p model.Payment
+	raw := map[string]interface{}{
+		"id":                     id,
+		"created":                created,
+		"updated":                created,
+		"amount":                 map[string]string{"value": amount, "currency": "EUR"},
+		"balance_after_mutation": map[string]string{"value": balance, "currency": "EUR"},
+		"counterparty_alias":     map[string]string{"iban": "NL65BUNQ9900000188", "display_name": counterparty},
+		"description":            description,
+		"type":                   "BUNQ",
+	}
+	b, err := json.Marshal(raw)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(b, &p))
+
+	return p
+}
+
+func createPayments(t *testing.T) []model.Payment {
+	// Newest first, the way bunq returns them.
+	return []model.Payment{
+		createPayment(t, 3, "2024-01-03 12:00:00.000000", "-25.50", "74.50", "Shop & Co", "groceries"),
+		createPayment(t, 2, "2024-01-02 12:00:00.000000", "-30.00", "100.00", "Landlord", "rent"),
+		createPayment(t, 1, "2024-01-01 12:00:00.000000", "100.00", "130.00", "S. Daddy", "salary"),
+	}
+}
+
+func TestNewStatement(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewStatement(loadAccount(t), createPayments(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, s.Payments[0].ID)
+	assert.Equal(t, 3, s.Payments[2].ID)
+	assert.Equal(t, "30.00", s.OpeningBalance.StringFixed(2))
+	assert.Equal(t, "74.50", s.ClosingBalance.StringFixed(2))
+	assert.Equal(t, "2024-01-01", s.From.Format("2006-01-02"))
+	assert.Equal(t, "2024-01-03", s.To.Format("2006-01-02"))
+}
+
+func TestNewStatementMissingBalance(t *testing.T) {
+	t.Parallel()
+
+	payments := createPayments(t)
+	payments[1].BalanceAfterMutation = model.Amount{}
+
+	_, err := NewStatement(loadAccount(t), payments)
+	assert.ErrorIs(t, err, ErrMissingBalance)
+}
+
+func TestStatement_WriteCAMT053(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewStatement(loadAccount(t), createPayments(t))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.WriteCAMT053(&buf))
+
+	var doc camtDocument
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	stmt := doc.Stmt.Stmt
+	assert.Equal(t, camt053Namespace, doc.Xmlns)
+	assert.Equal(t, "NL85BUNQ9900100611", stmt.Acct.IBAN)
+	assert.Equal(t, "OPBD", stmt.Bal[0].Code)
+	assert.Equal(t, "30.00", stmt.Bal[0].Amt.Value)
+	assert.Equal(t, "CLBD", stmt.Bal[1].Code)
+	assert.Equal(t, "74.50", stmt.Bal[1].Amt.Value)
+	if assert.Len(t, stmt.Ntry, 3) {
+		assert.Equal(t, camtCredit, stmt.Ntry[0].CdtDbtInd)
+		assert.Equal(t, "S. Daddy", stmt.Ntry[0].TxDtls.RltdPties.Dbtr.Nm)
+		assert.Equal(t, camtDebit, stmt.Ntry[2].CdtDbtInd)
+		assert.Equal(t, "25.50", stmt.Ntry[2].Amt.Value)
+		assert.Equal(t, "NL65BUNQ9900000188", stmt.Ntry[2].TxDtls.RltdPties.CdtrAcct.IBAN)
+		assert.Equal(t, "groceries", stmt.Ntry[2].TxDtls.Ustrd)
+	}
+	assert.Contains(t, buf.String(), "Shop &amp; Co")
+}
+
+func TestStatement_WriteOFX(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewStatement(loadAccount(t), createPayments(t))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.WriteOFX(&buf))
+	assert.True(t, strings.HasPrefix(buf.String(), xml.Header+ofxHeader))
+
+	var doc ofxDocument
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	stmt := doc.Bank.StmtRs
+	assert.Equal(t, "EUR", stmt.CurDef)
+	assert.Equal(t, "NL85BUNQ9900100611", stmt.BankAcctFrom.AcctID)
+	assert.Equal(t, "74.50", stmt.LedgerBal.BalAmt)
+	assert.Equal(t, "30.00", stmt.BalList[0].Value)
+	assert.Equal(t, "20240101120000.000[0:UTC]", stmt.TranList.DTStart)
+	if assert.Len(t, stmt.TranList.Trn, 3) {
+		assert.Equal(t, "CREDIT", stmt.TranList.Trn[0].TrnType)
+		assert.Equal(t, "DEBIT", stmt.TranList.Trn[1].TrnType)
+		assert.Equal(t, "-30.00", stmt.TranList.Trn[1].TrnAmt)
+		assert.Equal(t, "2", stmt.TranList.Trn[1].FITID)
+	}
+}
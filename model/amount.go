@@ -0,0 +1,214 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// ErrCurrencyMismatch is returned when calculating with amounts in different currencies.
+var ErrCurrencyMismatch = errors.New("bunq: amounts have different currencies")
+
+// Amount An amount of money in a currency.
+//
+// Decimal holds the amount, Value its string representation as sent by bunq.
+// Create amounts with NewAmount or ParseAmount, so both are set.
+type Amount struct {
+	Value    string          `json:"value"`
+	Currency string          `json:"currency"`
+	Decimal  decimal.Decimal `json:"-"`
+}
+
+// NewAmount creates an amount in currency. It returns an error if the amount has more than two decimals,
+// as bunq only accepts whole cents; round it with decimal.Decimal.Round first if that is intended.
+func NewAmount(d decimal.Decimal, currency string) (Amount, error) {
+	if !d.Equal(d.Round(2)) {
+		return Amount{}, errors.Errorf("bunq: invalid amount %s, at most two decimals are allowed", d)
+	}
+
+	return newAmount(d, currency), nil
+}
+
+// MustNewAmount is like NewAmount but panics if the amount has more than two decimals.
+func MustNewAmount(d decimal.Decimal, currency string) Amount {
+	a, err := NewAmount(d, currency)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+// newAmount creates an amount without checking its precision, Value has at least two decimals.
+func newAmount(d decimal.Decimal, currency string) Amount {
+	value := d.String()
+	if d.Equal(d.Round(2)) {
+		value = d.StringFixed(2)
+	}
+
+	return Amount{Value: value, Currency: currency, Decimal: d}
+}
+
+// ParseAmount parses an amount followed by its currency code, e.g. "12.34 EUR".
+func ParseAmount(s string) (Amount, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return Amount{}, errors.Errorf("bunq: invalid amount %q, expected a value and currency like 12.34 EUR", s)
+	}
+
+	d, err := decimal.NewFromString(fields[0])
+	if err != nil {
+		return Amount{}, errors.Wrapf(err, "bunq: invalid amount %q", s)
+	}
+	if !d.Equal(d.Round(2)) {
+		return Amount{}, errors.Errorf("bunq: invalid amount %q, at most two decimals are allowed", s)
+	}
+
+	currency := fields[1]
+	if !isCurrencyCode(currency) {
+		return Amount{}, errors.Errorf("bunq: invalid currency %q", currency)
+	}
+
+	return newAmount(d, currency), nil
+}
+
+// MustParseAmount is like ParseAmount but panics if the amount can't be parsed.
+func MustParseAmount(s string) Amount {
+	a, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+func isCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// decimal returns the amount, falling back to Value for amounts that were created without Decimal.
+func (a Amount) decimal() decimal.Decimal {
+	if a.Decimal.IsZero() && a.Value != "" {
+		if d, err := decimal.NewFromString(a.Value); err == nil {
+			return d
+		}
+	}
+
+	return a.Decimal
+}
+
+// Add returns the sum of both amounts, which must be in the same currency.
+func (a Amount) Add(b Amount) (Amount, error) {
+	if a.Currency != b.Currency {
+		return Amount{}, errors.Wrapf(ErrCurrencyMismatch, "adding %s to %s", b.Currency, a.Currency)
+	}
+
+	return newAmount(a.decimal().Add(b.decimal()), a.Currency), nil
+}
+
+// Sub returns a minus b, which must be in the same currency.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if a.Currency != b.Currency {
+		return Amount{}, errors.Wrapf(ErrCurrencyMismatch, "subtracting %s from %s", b.Currency, a.Currency)
+	}
+
+	return newAmount(a.decimal().Sub(b.decimal()), a.Currency), nil
+}
+
+// Neg returns the amount with its sign flipped.
+func (a Amount) Neg() Amount {
+	return newAmount(a.decimal().Neg(), a.Currency)
+}
+
+// Abs returns the absolute amount.
+func (a Amount) Abs() Amount {
+	return newAmount(a.decimal().Abs(), a.Currency)
+}
+
+// Cmp compares both amounts, which must be in the same currency.
+// It returns -1 if a is less than b, 0 if they are equal and 1 if a is greater than b.
+func (a Amount) Cmp(b Amount) (int, error) {
+	if a.Currency != b.Currency {
+		return 0, errors.Wrapf(ErrCurrencyMismatch, "comparing %s to %s", a.Currency, b.Currency)
+	}
+
+	return a.decimal().Cmp(b.decimal()), nil
+}
+
+// IsZero reports whether the amount is zero.
+func (a Amount) IsZero() bool {
+	return a.decimal().IsZero()
+}
+
+// IsPositive reports whether the amount is greater than zero.
+func (a Amount) IsPositive() bool {
+	return a.decimal().IsPositive()
+}
+
+// IsNegative reports whether the amount is less than zero.
+func (a Amount) IsNegative() bool {
+	return a.decimal().IsNegative()
+}
+
+// StringFixed returns the amount rounded to two decimals, without currency, e.g. "-12.30".
+func (a Amount) StringFixed() string {
+	return a.decimal().StringFixed(2)
+}
+
+// String returns the amount rounded to two decimals followed by its currency, e.g. "-12.30 EUR".
+func (a Amount) String() string {
+	return a.StringFixed() + " " + a.Currency
+}
+
+// MarshalJSON encodes the amount as bunq expects it, with the value derived from Decimal
+// with exactly two decimals. Values that are not numbers, and the value of the zero Amount,
+// are encoded unchanged. Amounts with more than two decimals are not rounded but return an error.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	value := a.Value
+	if _, err := decimal.NewFromString(a.Value); err == nil || !a.Decimal.IsZero() || (a.Value == "" && a.Currency != "") {
+		d := a.decimal()
+		if !d.Equal(d.Round(2)) {
+			return nil, errors.Errorf("bunq: amount %s %s has more than two decimals", d, a.Currency)
+		}
+		value = d.StringFixed(2)
+	}
+
+	return json.Marshal(struct {
+		Value    string `json:"value"`
+		Currency string `json:"currency"`
+	}{value, a.Currency})
+}
+
+// UnmarshalJSON decodes an amount sent by bunq, setting both Value and Decimal.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Value    string `json:"value"`
+		Currency string `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	a.Currency = raw.Currency
+	a.Value = raw.Value
+
+	dec, err := decimal.NewFromString(raw.Value)
+	if err != nil {
+		a.Decimal = decimal.Zero
+	} else {
+		a.Decimal = dec
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	t.Parallel()
+
+	a, err := ParseAmount("12.5 EUR")
+	require.NoError(t, err)
+	assert.Equal(t, "12.50", a.Value)
+	assert.Equal(t, "EUR", a.Currency)
+	assert.True(t, a.Decimal.Equal(decimal.RequireFromString("12.5")))
+	assert.Equal(t, "12.50 EUR", a.String())
+
+	for _, s := range []string{"12.50", "12.345 EUR", "twelve EUR", "12.50 eur", "12.50 EURO", "12.50 EUR extra"} {
+		_, err := ParseAmount(s)
+		assert.Error(t, err, s)
+	}
+
+	assert.Panics(t, func() { MustParseAmount("12.50") })
+	assert.Equal(t, "-0.01", MustParseAmount("-0.010 USD").Value)
+
+	_, err = NewAmount(decimal.RequireFromString("12.345"), "EUR")
+	assert.Error(t, err)
+	assert.Panics(t, func() { MustNewAmount(decimal.RequireFromString("0.001"), "EUR") })
+	assert.Equal(t, "12.30", MustNewAmount(decimal.RequireFromString("12.3"), "EUR").Value)
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	t.Parallel()
+
+	rent := MustParseAmount("750.00 EUR")
+	balance := MustParseAmount("1000.10 EUR")
+
+	left, err := balance.Sub(rent)
+	require.NoError(t, err)
+	assert.Equal(t, "250.10 EUR", left.String())
+
+	sum, err := left.Add(rent.Neg())
+	require.NoError(t, err)
+	assert.True(t, sum.IsNegative())
+	assert.Equal(t, "499.90 EUR", sum.Abs().String())
+
+	cmp, err := rent.Cmp(balance)
+	require.NoError(t, err)
+	assert.Equal(t, -1, cmp)
+
+	_, err = rent.Add(MustParseAmount("1.00 USD"))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = rent.Sub(MustParseAmount("1.00 USD"))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	_, err = rent.Cmp(MustParseAmount("1.00 USD"))
+	assert.ErrorIs(t, err, ErrCurrencyMismatch)
+
+	legacy := Amount{Value: "12.5", Currency: "EUR"}
+	assert.True(t, legacy.IsPositive())
+	sum, err = legacy.Add(MustParseAmount("0.50 EUR"))
+	require.NoError(t, err)
+	assert.Equal(t, "13.00", sum.Value)
+}
+
+func TestAmountJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		amount Amount
+		json   string
+	}{
+		{MustNewAmount(decimal.RequireFromString("12.3"), "EUR"), `{"value":"12.30","currency":"EUR"}`},
+		{Amount{Decimal: decimal.NewFromInt(3), Value: "1.00", Currency: "EUR"}, `{"value":"3.00","currency":"EUR"}`},
+		{Amount{Value: "12.5", Currency: "EUR"}, `{"value":"12.50","currency":"EUR"}`},
+		{Amount{Currency: "EUR"}, `{"value":"0.00","currency":"EUR"}`},
+		{Amount{}, `{"value":"","currency":""}`},
+		{Amount{Value: "string", Currency: "string"}, `{"value":"string","currency":"string"}`},
+	}
+	for _, tt := range tests {
+		raw, err := json.Marshal(tt.amount)
+		require.NoError(t, err)
+		assert.JSONEq(t, tt.json, string(raw))
+	}
+
+	for _, a := range []Amount{{Decimal: decimal.RequireFromString("12.345"), Currency: "EUR"}, {Value: "0.001", Currency: "EUR"}} {
+		_, err := json.Marshal(a)
+		assert.Error(t, err, "sub-cent amounts are not rounded")
+	}
+
+	var a Amount
+	require.NoError(t, json.Unmarshal([]byte(`{"value":"-7.10","currency":"EUR"}`), &a))
+	assert.Equal(t, MustParseAmount("-7.10 EUR"), a)
+}
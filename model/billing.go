@@ -0,0 +1,161 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DateLayout is the layout of the dates without time used by bunq, like the dates of invoices and billing contracts.
+const DateLayout = "2006-01-02"
+
+// Invoice An invoice of bunq to the user, for its subscription and the fees of its accounts.
+type Invoice struct {
+	Common
+	InvoiceDate                  string                         `json:"invoice_date"`
+	InvoiceNumber                string                         `json:"invoice_number"`
+	Status                       string                         `json:"status"`
+	Category                     string                         `json:"category"`
+	Group                        []InvoiceItemGroup             `json:"group"`
+	TotalVatInclusive            Amount                         `json:"total_vat_inclusive"`
+	TotalVatExclusive            Amount                         `json:"total_vat_exclusive"`
+	TotalVat                     Amount                         `json:"total_vat"`
+	Alias                        LabelMonetaryAccount           `json:"alias"`
+	Address                      Address                        `json:"address"`
+	CounterpartyAlias            LabelMonetaryAccount           `json:"counterparty_alias"`
+	CounterpartyAddress          Address                        `json:"counterparty_address"`
+	ChamberOfCommerceNumber      string                         `json:"chamber_of_commerce_number"`
+	VatNumber                    string                         `json:"vat_number"`
+	RequestReferenceSplitTheBill []RequestReferenceSplitTheBill `json:"request_reference_split_the_bill"`
+}
+
+// InvoiceItemGroup A group of items of an invoice of the same type, like the fees of one account.
+type InvoiceItemGroup struct {
+	Type                      string        `json:"type"`
+	TypeDescription           string        `json:"type_description"`
+	TypeDescriptionTranslated string        `json:"type_description_translated"`
+	InstanceDescription       string        `json:"instance_description"`
+	ProductVatExclusive       Amount        `json:"product_vat_exclusive"`
+	ProductVatInclusive       Amount        `json:"product_vat_inclusive"`
+	Item                      []InvoiceItem `json:"item"`
+}
+
+// InvoiceItem A line of an invoice. Vat is the rate of the VAT, e.g. 0.21.
+type InvoiceItem struct {
+	BillingDate               string  `json:"billing_date"`
+	TypeDescription           string  `json:"type_description"`
+	TypeDescriptionTranslated string  `json:"type_description_translated"`
+	UnitVatExclusive          Amount  `json:"unit_vat_exclusive"`
+	UnitVatInclusive          Amount  `json:"unit_vat_inclusive"`
+	Vat                       float64 `json:"vat"`
+	Quantity                  float64 `json:"quantity"`
+	TotalVatExclusive         Amount  `json:"total_vat_exclusive"`
+	TotalVatInclusive         Amount  `json:"total_vat_inclusive"`
+}
+
+// Items returns the items of all groups of the invoice.
+func (i Invoice) Items() []InvoiceItem {
+	var items []InvoiceItem
+	for _, g := range i.Group {
+		items = append(items, g.Item...)
+	}
+
+	return items
+}
+
+// BillingContract returns the contract of the given ones the invoice was sent for,
+// the one that covered the date of the invoice, and false if there is none.
+func (i Invoice) BillingContract(contracts []BillingContract) (BillingContract, bool, error) {
+	date, err := parseDate(i.InvoiceDate)
+	if err != nil {
+		return BillingContract{}, false, err
+	}
+
+	return BillingContractAt(contracts, date)
+}
+
+// UnmarshalJSON decodes a billing contract, either as is or wrapped in an object with the key
+// BillingContractSubscription, as bunq sends the contracts of a user.
+func (b *BillingContract) UnmarshalJSON(data []byte) error {
+	type billingContract BillingContract
+
+	var wrapped struct {
+		BillingContractSubscription *billingContract `json:"BillingContractSubscription"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err != nil {
+		return err
+	}
+
+	if wrapped.BillingContractSubscription != nil {
+		*b = BillingContract(*wrapped.BillingContractSubscription)
+		return nil
+	}
+
+	return json.Unmarshal(data, (*billingContract)(b))
+}
+
+// Covers returns whether the contract was in effect on the day of t: from its start date
+// until its end date, which is not included, or without an end date.
+func (b BillingContract) Covers(t time.Time) (bool, error) {
+	start, err := parseDate(b.ContractDateStart)
+	if err != nil {
+		return false, err
+	}
+
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if day.Before(start) {
+		return false, nil
+	}
+
+	if b.ContractDateEnd == "" {
+		return true, nil
+	}
+
+	end, err := parseDate(b.ContractDateEnd)
+	if err != nil {
+		return false, err
+	}
+
+	return day.Before(end), nil
+}
+
+// BillingContractAt returns the contract of the given ones that covered the day of t, and false if there is none.
+// If several did, the one that started last is returned.
+func BillingContractAt(contracts []BillingContract, t time.Time) (BillingContract, bool, error) {
+	var (
+		found BillingContract
+		ok    bool
+	)
+
+	for _, c := range contracts {
+		covers, err := c.Covers(t)
+		if err != nil {
+			return BillingContract{}, false, err
+		}
+
+		if covers && (!ok || c.ContractDateStart > found.ContractDateStart) {
+			found, ok = c, true
+		}
+	}
+
+	return found, ok, nil
+}
+
+// ActiveBillingContract returns the contract of the company that covers today, and false if there is none.
+func (u UserCompany) ActiveBillingContract() (BillingContract, bool, error) {
+	return BillingContractAt(u.BillingContract, time.Now())
+}
+
+func parseDate(s string) (time.Time, error) {
+	if len(s) > len(DateLayout) {
+		s = s[:len(DateLayout)]
+	}
+
+	t, err := time.ParseInLocation(DateLayout, s, time.UTC)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "bunq: invalid date %q", s)
+	}
+
+	return t, nil
+}
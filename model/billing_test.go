@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserCompanyBillingContract(t *testing.T) {
+	t.Parallel()
+
+	var res ResponseUser
+	loadResponse(t, "user_company_get_response", &res)
+
+	company := res.User().(UserCompany)
+	require.Len(t, company.BillingContract, 2)
+	assert.Equal(t, 24100, company.BillingContract[0].ID)
+	assert.Equal(t, "2019-06-01", company.BillingContract[0].ContractDateEnd)
+
+	contract, ok, err := company.ActiveBillingContract()
+	assert.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "COMPANY_V2", contract.SubscriptionType)
+}
+
+func TestBillingContractUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var contract BillingContract
+	require.NoError(t, json.Unmarshal([]byte(`{"id":12,"subscription_type":"PERSON_PREMIUM_V1"}`), &contract))
+	assert.Equal(t, 12, contract.ID)
+
+	raw, err := json.Marshal(contract)
+	require.NoError(t, err)
+
+	var decoded BillingContract
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, contract, decoded)
+}
+
+func TestBillingContractAt(t *testing.T) {
+	t.Parallel()
+
+	contracts := []BillingContract{
+		{ID: 1, ContractDateStart: "2018-11-18", ContractDateEnd: "2019-06-01"},
+		{ID: 2, ContractDateStart: "2019-06-01"},
+	}
+
+	for _, tc := range []struct {
+		day string
+		id  int
+		ok  bool
+	}{
+		{day: "2018-11-17", ok: false},
+		{day: "2018-11-18", id: 1, ok: true},
+		{day: "2019-05-31", id: 1, ok: true},
+		{day: "2019-06-01", id: 2, ok: true},
+		{day: "2024-01-01", id: 2, ok: true},
+	} {
+		day, err := time.Parse(DateLayout, tc.day)
+		require.NoError(t, err)
+
+		contract, ok, err := BillingContractAt(contracts, day.Add(15*time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, tc.ok, ok, tc.day)
+		assert.Equal(t, tc.id, contract.ID, tc.day)
+	}
+
+	_, _, err := BillingContractAt([]BillingContract{{ContractDateStart: "soon"}}, time.Now())
+	assert.Error(t, err)
+
+	_, _, err = Invoice{InvoiceDate: ""}.BillingContract(contracts)
+	assert.Error(t, err)
+}
@@ -1,89 +1,94 @@
 package model
 
-import (
-	"encoding/json"
-	"github.com/shopspring/decimal"
-)
-
+// Installation The installation of a device, holding the token and the key bunq signs its responses with.
 type Installation struct {
-	ID              bunqID          `json:"Id"`
-	Token           token           `json:"Token"`
-	ServerPublicKey serverPublicKey `json:"ServerPublicKey"`
+	ID              BunqID          `json:"Id"`
+	Token           Token           `json:"Token"`
+	ServerPublicKey ServerPublicKey `json:"ServerPublicKey"`
 }
 
-type bunqID struct {
+// BunqID The id of a created bunq object.
+type BunqID struct {
 	ID int `json:"id"`
 }
 
-type wrappedBunqID struct {
-	ID bunqID `json:"Id"`
+// WrappedBunqID The id of a created bunq object as returned in responses.
+type WrappedBunqID struct {
+	ID BunqID `json:"Id"`
 }
 
-type token struct {
-	common
+// Token An installation or session token.
+type Token struct {
+	Common
 	Token string `json:"token"`
 }
 
-type serverPublicKey struct {
+// ServerPublicKey The public key bunq signs its responses with.
+type ServerPublicKey struct {
 	ServerPublicKey string `json:"server_public_key"`
 }
 
-type common struct {
-	ID      int    `json:"id"`
-	Created string `json:"created"`
-	Updated string `json:"updated"`
+// Common The fields all bunq objects have.
+type Common struct {
+	ID      int  `json:"id"`
+	Created Time `json:"created"`
+	Updated Time `json:"updated"`
 }
 
+// SessionServer A session, holding the token and the user it belongs to.
 type SessionServer struct {
-	ID          bunqID      `json:"Id"`
-	Token       token       `json:"Token"`
-	UserCompany userCompany `json:"UserCompany"`
-	UserPerson  userPerson  `json:"UserPerson"`
-	UserAPIKey  userAPIKey  `json:"UserApiKey"`
-}
-
-type user struct {
-	common
-	PublicUUID                         string                             `json:"public_uuid"`
-	AddressMain                        address                            `json:"address_main"`
-	Alias                              []alias                            `json:"alias"`
-	AddressPostal                      address                            `json:"address_postal"`
-	Avatar                             avatar                             `json:"avatar"`
-	Status                             string                             `json:"status"`
-	SubStatus                          string                             `json:"sub_status"`
-	Region                             string                             `json:"region"`
-	Language                           string                             `json:"language"`
-	DailyLimitWithoutConfirmationLogin dailyLimitWithoutConfirmationLogin `json:"daily_limit_without_confirmation_login"`
-	NotificationFilters                []NotificationFilter               `json:"notification_filters"`
-	VersionTermsOfService              string                             `json:"version_terms_of_service"`
-	SessionTimeout                     int64                              `json:"session_timeout"`
-	DisplayName                        string                             `json:"display_name"`
-	PublicNickName                     string                             `json:"public_nick_name"`
-}
-
-type userCompany struct {
-	user
+	ID          BunqID      `json:"Id"`
+	Token       Token       `json:"Token"`
+	UserCompany UserCompany `json:"UserCompany"`
+	UserPerson  UserPerson  `json:"UserPerson"`
+	UserAPIKey  UserAPIKey  `json:"UserApiKey"`
+}
+
+// BaseUser The fields shared by persons and companies.
+type BaseUser struct {
+	Common
+	PublicUUID                         string               `json:"public_uuid"`
+	AddressMain                        Address              `json:"address_main"`
+	Alias                              []Alias              `json:"alias"`
+	AddressPostal                      Address              `json:"address_postal"`
+	Avatar                             Avatar               `json:"avatar"`
+	Status                             string               `json:"status"`
+	SubStatus                          string               `json:"sub_status"`
+	Region                             string               `json:"region"`
+	Language                           string               `json:"language"`
+	DailyLimitWithoutConfirmationLogin Amount               `json:"daily_limit_without_confirmation_login"`
+	NotificationFilters                []NotificationFilter `json:"notification_filters"`
+	VersionTermsOfService              string               `json:"version_terms_of_service"`
+	SessionTimeout                     int64                `json:"session_timeout"`
+	DisplayName                        string               `json:"display_name"`
+	PublicNickName                     string               `json:"public_nick_name"`
+}
+
+// UserCompany A company using bunq.
+type UserCompany struct {
+	BaseUser
 	Name                    string            `json:"name"`
 	Country                 string            `json:"country"`
-	Ubo                     []ubo             `json:"ubo"`
+	Ubo                     []UBO             `json:"ubo"`
 	ChamberOfCommerceNumber string            `json:"chamber_of_commerce_number"`
 	TypeOfBusinessEntity    string            `json:"type_of_business_entity"`
 	SectorOfIndustry        string            `json:"sector_of_industry"`
 	CounterBankIban         string            `json:"counter_bank_iban"`
-	DirectorAlias           directorAlias     `json:"director_alias"`
-	CardIds                 []bunqID          `json:"card_ids"`
-	CardLimits              []cardLimits      `json:"card_limits"`
-	Customer                customer          `json:"customer"`
-	CustomerLimit           customer          `json:"customer_limit"`
-	BillingContract         []billingContract `json:"billing_contract"`
+	DirectorAlias           DirectorAlias     `json:"director_alias"`
+	CardIds                 []BunqID          `json:"card_ids"`
+	CardLimits              []CardLimit       `json:"card_limits"`
+	Customer                Customer          `json:"customer"`
+	CustomerLimit           CustomerLimit     `json:"customer_limit"`
+	BillingContract         []BillingContract `json:"billing_contract"`
 }
 
-type userPerson struct {
-	user
+// UserPerson A person using bunq.
+type UserPerson struct {
+	BaseUser
 	FirstName                 string        `json:"first_name"`
 	MiddleName                string        `json:"middle_name"`
 	LastName                  string        `json:"last_name"`
-	TaxResident               []taxResident `json:"tax_resident"`
+	TaxResident               []TaxResident `json:"tax_resident"`
 	DocumentType              string        `json:"document_type"`
 	DocumentNumber            string        `json:"document_number"`
 	DocumentCountryOfIssuance string        `json:"document_country_of_issuance"`
@@ -92,11 +97,12 @@ type userPerson struct {
 	CountryOfBirth            string        `json:"country_of_birth"`
 	Nationality               string        `json:"nationality"`
 	Gender                    string        `json:"gender"`
-	LegalGuardianAlias        alias         `json:"legal_guardian_alias"`
+	LegalGuardianAlias        Alias         `json:"legal_guardian_alias"`
 	LegalName                 string        `json:"legal_name"`
 }
 
-type address struct {
+// Address A postal address.
+type Address struct {
 	Street      string `json:"street"`
 	HouseNumber string `json:"house_number"`
 	PoBox       string `json:"po_box"`
@@ -106,63 +112,129 @@ type address struct {
 	Province    string `json:"province"`
 }
 
-type ubo struct {
+// UBO An ultimate beneficial owner of a company.
+type UBO struct {
 	Name        string `json:"name"`
 	DateOfBirth string `json:"date_of_birth"`
 	Nationality string `json:"nationality"`
 }
 
-type dailyLimitWithoutConfirmationLogin Amount
-
+// NotificationFilter A notification filter of a user or monetary account.
 type NotificationFilter struct {
 	NotificationDeliveryMethod string `json:"notification_delivery_method"`
 	NotificationTarget         string `json:"notification_target"`
 	Category                   string `json:"category"`
 }
 
-type alias struct {
+// NotificationCategory is the category of events a notification filter subscribes to.
+type NotificationCategory string
+
+// The notification categories supported by bunq.
+const (
+	NotificationCategoryBilling                   NotificationCategory = "BILLING"
+	NotificationCategoryBunqMeTab                 NotificationCategory = "BUNQME_TAB"
+	NotificationCategoryCardTransactionFailed     NotificationCategory = "CARD_TRANSACTION_FAILED"
+	NotificationCategoryCardTransactionSuccessful NotificationCategory = "CARD_TRANSACTION_SUCCESSFUL"
+	NotificationCategoryChat                      NotificationCategory = "CHAT"
+	NotificationCategoryDraftPayment              NotificationCategory = "DRAFT_PAYMENT"
+	NotificationCategoryIdeal                     NotificationCategory = "IDEAL"
+	NotificationCategoryMutation                  NotificationCategory = "MUTATION"
+	NotificationCategoryOAuth                     NotificationCategory = "OAUTH"
+	NotificationCategoryPayment                   NotificationCategory = "PAYMENT"
+	NotificationCategoryRequest                   NotificationCategory = "REQUEST"
+	NotificationCategoryScheduleResult            NotificationCategory = "SCHEDULE_RESULT"
+	NotificationCategoryScheduleStatus            NotificationCategory = "SCHEDULE_STATUS"
+	NotificationCategoryShare                     NotificationCategory = "SHARE"
+	NotificationCategorySofort                    NotificationCategory = "SOFORT"
+	NotificationCategorySupport                   NotificationCategory = "SUPPORT"
+	NotificationCategoryTabResult                 NotificationCategory = "TAB_RESULT"
+)
+
+// NotificationFilterURL is a URL callback that bunq calls for every event of the category.
+type NotificationFilterURL struct {
+	ID                 int                  `json:"id,omitempty"`
+	Created            Time                 `json:"created"`
+	Updated            Time                 `json:"updated"`
+	Category           NotificationCategory `json:"category"`
+	NotificationTarget string               `json:"notification_target"`
+}
+
+// NewNotificationFilterURLs creates a URL callback to target for each of the categories.
+func NewNotificationFilterURLs(target string, categories ...NotificationCategory) []NotificationFilterURL {
+	filters := make([]NotificationFilterURL, 0, len(categories))
+	for _, c := range categories {
+		filters = append(filters, NotificationFilterURL{Category: c, NotificationTarget: target})
+	}
+
+	return filters
+}
+
+// OAuthClient An OAuth client of the user, used to access the accounts of other bunq users.
+type OAuthClient struct {
+	ID          int                `json:"id"`
+	Status      string             `json:"status"`
+	DisplayName string             `json:"display_name"`
+	ClientID    string             `json:"client_id"`
+	Secret      string             `json:"secret"`
+	CallbackURL []OAuthCallbackURL `json:"callback_url"`
+}
+
+// OAuthCallbackURL A URL bunq may redirect the user to after granting an OAuth client access.
+type OAuthCallbackURL struct {
+	Common
+	URL string `json:"url"`
+}
+
+// Alias An alias of a user, like an email address or phone number.
+type Alias struct {
 	Type  string `json:"type"`
 	Value string `json:"value"`
 	Name  string `json:"name"`
 }
 
-type avatar struct {
+// Avatar The avatar of a user or monetary account.
+type Avatar struct {
 	UUID       string  `json:"uuid"`
 	AnchorUUID string  `json:"anchor_uuid"`
-	Image      []image `json:"image"`
+	Image      []Image `json:"image"`
 }
 
-type image struct {
+// Image An image of an avatar.
+type Image struct {
 	AttachmentPublicUUID string `json:"attachment_public_uuid"`
 	ContentType          string `json:"content_type"`
 	Height               int    `json:"height"`
 	Width                int    `json:"width"`
 }
 
-type directorAlias struct {
+// DirectorAlias The director of a company.
+type DirectorAlias struct {
 	UUID           string `json:"uuid"`
 	DisplayName    string `json:"display_name"`
 	Country        string `json:"country"`
-	Avatar         avatar `json:"avatar"`
+	Avatar         Avatar `json:"avatar"`
 	PublicNickName string `json:"public_nick_name"`
 }
 
-type cardLimits struct {
+// CardLimit The limit of a card type.
+type CardLimit struct {
 	DailyLimit string `json:"daily_limit"`
 	Currency   string `json:"currency"`
 	Type       string `json:"type"`
 	ID         int    `json:"id"`
 }
 
-type customer struct {
+// Customer The customer details of a company.
+type Customer struct {
 	BillingAccountID              float64 `json:"billing_account_id"`
 	InvoiceNotificationPreference string  `json:"invoice_notification_preference"`
 	ID                            int     `json:"id"`
-	Created                       string  `json:"created"`
-	Updated                       string  `json:"updated"`
+	Created                       Time    `json:"created"`
+	Updated                       Time    `json:"updated"`
 }
 
-type customerLimit struct {
+// CustomerLimit The limits of a company.
+type CustomerLimit struct {
 	LimitMonetaryAccount          int    `json:"limit_monetary_account"`
 	LimitCardDebitMaestro         int    `json:"limit_card_debit_maestro"`
 	LimitCardDebitMastercard      int    `json:"limit_card_debit_mastercard"`
@@ -173,39 +245,12 @@ type customerLimit struct {
 	SpentAmountMonthly            Amount `json:"spent_amount_monthly"`
 }
 
-type Amount struct {
-	Value    string          `json:"value"`
-	Currency string          `json:"currency"`
-	Decimal  decimal.Decimal `json:"-"`
-}
-
-func (a *Amount) UnmarshalJSON(data []byte) error {
-	var raw struct {
-		Value    string `json:"value"`
-		Currency string `json:"currency"`
-	}
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return err
-	}
-
-	a.Currency = raw.Currency
-	a.Value = raw.Value
-
-	dec, err := decimal.NewFromString(raw.Value)
-	if err != nil {
-		a.Decimal = decimal.Zero
-	} else {
-		a.Decimal = dec
-	}
-
-	return nil
-}
-
-type billingContract struct {
+// BillingContract The subscription of a company.
+type BillingContract struct {
 	SubscriptionType          string `json:"subscription_type"`
 	ID                        int    `json:"id"`
-	Created                   string `json:"created"`
-	Updated                   string `json:"updated"`
+	Created                   Time   `json:"created"`
+	Updated                   Time   `json:"updated"`
 	ContractDateStart         string `json:"contract_date_start"`
 	ContractDateEnd           string `json:"contract_date_end"`
 	ContractVersion           int    `json:"contract_version"`
@@ -214,26 +259,24 @@ type billingContract struct {
 	SubStatus                 string `json:"sub_status"`
 }
 
-type taxResident struct {
+// TaxResident A country a person is a tax resident of.
+type TaxResident struct {
 	Country   string `json:"country"`
 	TaxNumber string `json:"tax_number"`
 	Status    string `json:"status"`
 }
 
-type userAPIKey struct {
-	common
-	RequestedByUser requestedByUser `json:"requested_by_user"`
-	GrantedByUser   grantedByUser   `json:"granted_by_user"`
-}
-
-type grantedByUser struct {
-	UserPerson  userPerson  `json:"UserPerson"`
-	UserCompany userCompany `json:"UserCompany"`
+// UserAPIKey A user that was granted access to another user with an API key.
+type UserAPIKey struct {
+	Common
+	RequestedByUser UserReference `json:"requested_by_user"`
+	GrantedByUser   UserReference `json:"granted_by_user"`
 }
 
-type requestedByUser struct {
-	UserPerson  userPerson  `json:"UserPerson"`
-	UserCompany userCompany `json:"UserCompany"`
+// UserReference holds either the person or the company a user refers to.
+type UserReference struct {
+	UserPerson  UserPerson  `json:"UserPerson"`
+	UserCompany UserCompany `json:"UserCompany"`
 }
 
 // Pointer The pointer alias of a monetary account
@@ -256,9 +299,9 @@ type ClientContext struct {
 
 // MonetaryAccountBank The monetary account bank.
 type MonetaryAccountBank struct {
-	common
+	Common
 	Alias                  []Pointer              `json:"alias"`
-	Avatar                 avatar                 `json:"avatar"`
+	Avatar                 Avatar                 `json:"avatar"`
 	Balance                Amount                 `json:"balance"`
 	Country                string                 `json:"country"`
 	Currency               string                 `json:"currency"`
@@ -270,9 +313,9 @@ type MonetaryAccountBank struct {
 	SubStatus              string                 `json:"sub_status"`
 	Timezone               string                 `json:"timezone"`
 	UserID                 int                    `json:"user_id"`
-	MonetaryAccountProfile monetaryAccountProfile `json:"monetary_account_profile"`
+	MonetaryAccountProfile MonetaryAccountProfile `json:"monetary_account_profile"`
 	NotificationFilters    []NotificationFilter   `json:"notification_filters"`
-	Setting                monetaryAccountSetting `json:"setting"`
+	Setting                MonetaryAccountSetting `json:"setting"`
 	OverdraftLimit         Amount                 `json:"overdraft_limit"`
 }
 
@@ -288,7 +331,7 @@ func (m *MonetaryAccountBank) GetIBAN() string {
 
 func getIBANPointer(allP []Pointer) *Pointer {
 	for _, p := range allP {
-		if p.PType == "IBAN" {
+		if p.PType == PointerTypeIBAN {
 			return &p
 		}
 	}
@@ -305,65 +348,72 @@ func getIBAN(allP []Pointer) string {
 	return pointer.Value
 }
 
-type monetaryAccountProfile struct {
+// MonetaryAccountProfile The profile to fill or drain a monetary account with.
+type MonetaryAccountProfile struct {
 	ProfileFill           interface{} `json:"profile_fill"`
 	ProfileDrain          interface{} `json:"profile_drain"`
 	ProfileActionRequired string      `json:"profile_action_required"`
 	ProfileAmountRequired Amount      `json:"profile_amount_required"`
 }
 
-type monetaryAccountSetting struct {
+// MonetaryAccountSetting The settings of a monetary account.
+type MonetaryAccountSetting struct {
 	Color               string `json:"color"`
 	DefaultAvatarStatus string `json:"default_avatar_status"`
 	RestrictionChat     string `json:"restriction_chat"`
 }
 
-type draftPayment struct {
-	common
+// DraftPayment A payment that still has to be accepted in the bunq app.
+type DraftPayment struct {
+	Common
 	MonetaryAccountID            int                 `json:"monetary_account_id"`
 	Status                       string              `json:"status"`
 	Type                         string              `json:"type"`
-	UserAliasCreated             labelUser           `json:"user_alias_created"`
+	UserAliasCreated             LabelUser           `json:"user_alias_created"`
 	Responses                    interface{}         `json:"responses"`
 	Entries                      []DraftPaymentEntry `json:"entries"`
 	Object                       interface{}         `json:"object"`
 	RequestReferenceSplitTheBill []interface{}       `json:"request_reference_split_the_bill"`
 }
 
-type labelUser struct {
+// LabelUser The public details of a user.
+type LabelUser struct {
 	UUID           string `json:"uuid"`
 	DisplayName    string `json:"display_name"`
 	Country        string `json:"country"`
-	Avatar         avatar `json:"avatar"`
+	Avatar         Avatar `json:"avatar"`
 	PublicNickName string `json:"public_nick_name"`
 }
 
+// DraftPaymentEntry A single payment of a draft payment.
 type DraftPaymentEntry struct {
 	Amount            Amount                      `json:"amount"`
 	Alias             LabelMonetaryAccount        `json:"alias"`
 	CounterpartyAlias LabelMonetaryAccount        `json:"counterparty_alias"`
 	Description       string                      `json:"description"`
 	Type              string                      `json:"type"`
-	Attachment        []monetaryAccountAttachment `json:"monetaryAccountAttachment"`
+	Attachment        []MonetaryAccountAttachment `json:"monetaryAccountAttachment"`
 	MerchantReference string                      `json:"merchant_reference"`
 }
 
+// LabelMonetaryAccount The public details of a monetary account, like the counterparty of a payment.
 type LabelMonetaryAccount struct {
 	IBAN                      string    `json:"iban"`
 	IsLight                   bool      `json:"is_light"`
 	DisplayName               string    `json:"display_name"`
-	Avatar                    avatar    `json:"avatar"`
-	LabelUser                 labelUser `json:"label_user"`
+	Avatar                    Avatar    `json:"avatar"`
+	LabelUser                 LabelUser `json:"label_user"`
 	Country                   string    `json:"country"`
 	SwiftBic                  string    `json:"swift_bic"`
 	SwiftAccountNumber        string    `json:"swift_account_number"`
 	TransferwiseAccountNumber string    `json:"transferwise_account_number"`
 	TransferwiseBankCode      string    `json:"transferwise_bank_code"`
-	BunqMe                    bunqMe    `json:"bunq_me"`
+	BunqMe                    BunqMe    `json:"bunq_me"`
 }
 
-type masterCardAction struct {
-	common
+// MasterCardAction A card transaction or authorisation.
+type MasterCardAction struct {
+	Common
 	MonetaryAccountID             int           `json:"monetary_account_id"`
 	CardID                        int           `json:"card_id"`
 	CardAuthorisationIDResponse   string        `json:"card_authorisation_id_response"`
@@ -381,11 +431,11 @@ type masterCardAction struct {
 	AuthorisationType             string        `json:"authorisation_type"`
 	SettlementStatus              string        `json:"settlement_status"`
 	City                          string        `json:"city"`
-	Alias                         labelUser     `json:"alias"`
-	CounterpartyAlias             labelUser     `json:"counterparty_alias"`
-	LabelCard                     labelCard     `json:"label_card"`
+	Alias                         LabelUser     `json:"alias"`
+	CounterpartyAlias             LabelUser     `json:"counterparty_alias"`
+	LabelCard                     LabelCard     `json:"label_card"`
 	TokenStatus                   string        `json:"token_status"`
-	ReservationExpiryTime         string        `json:"reservation_expiry_time"`
+	ReservationExpiryTime         Time          `json:"reservation_expiry_time"`
 	AllowChat                     bool          `json:"allow_chat"`
 	PanEntryModeUser              string        `json:"pan_entry_mode_user"`
 	EligibleWhitelistID           int           `json:"eligible_whitelist_id"`
@@ -395,20 +445,21 @@ type masterCardAction struct {
 	AppliedLimit                  string        `json:"applied_limit"`
 }
 
-type labelCard struct {
+// LabelCard The public details of a card.
+type LabelCard struct {
 	UUID       string    `json:"uuid"`
 	Type       string    `json:"type"`
 	SecondLine string    `json:"second_line"`
 	ExpiryDate string    `json:"expiry_date"`
 	Status     string    `json:"status"`
-	LabelUser  labelUser `json:"label_user"`
+	LabelUser  LabelUser `json:"label_user"`
 }
 
 // MonetaryAccountSaving The monetary account saving.
 type MonetaryAccountSaving struct {
-	common
+	Common
 	Alias                  []Pointer              `json:"alias"`
-	Avatar                 avatar                 `json:"avatar"`
+	Avatar                 Avatar                 `json:"avatar"`
 	Balance                Amount                 `json:"balance"`
 	Country                string                 `json:"country"`
 	Currency               string                 `json:"currency"`
@@ -420,9 +471,9 @@ type MonetaryAccountSaving struct {
 	SubStatus              string                 `json:"sub_status"`
 	Timezone               string                 `json:"timezone"`
 	UserID                 int                    `json:"user_id"`
-	MonetaryAccountProfile monetaryAccountProfile `json:"monetary_account_profile"`
+	MonetaryAccountProfile MonetaryAccountProfile `json:"monetary_account_profile"`
 	NotificationFilters    []NotificationFilter   `json:"notification_filters"`
-	Setting                monetaryAccountSetting `json:"setting"`
+	Setting                MonetaryAccountSetting `json:"setting"`
 	OverdraftLimit         Amount                 `json:"overdraft_limit"`
 	SavingsGoal            Amount                 `json:"savings_goal"`
 	SavingsGoalProgress    string                 `json:"savings_goal_progress"`
@@ -447,8 +498,9 @@ const (
 	PaymentDirectionOutgoing PaymentDirection = "OUTGOING" // Represents an outgoing payment.
 )
 
+// Payment A payment from or to a monetary account.
 type Payment struct {
-	common
+	Common
 	MonetaryAccountID            int                            `json:"monetary_account_id"`
 	Amount                       Amount                         `json:"amount"`
 	Alias                        LabelMonetaryAccount           `json:"alias"`
@@ -459,30 +511,30 @@ type Payment struct {
 	BunqtoStatus                 string                         `json:"bunqto_status"`
 	BunqtoSubStatus              string                         `json:"bunqto_sub_status"`
 	BunqtoShareURL               string                         `json:"bunqto_share_url"`
-	BunqtoExpiry                 string                         `json:"bunqto_expiry"`
-	BunqtoTimeResponded          string                         `json:"bunqto_time_responded"`
-	Attachment                   []monetaryAccountAttachment    `json:"monetaryAccountAttachment"`
+	BunqtoExpiry                 Time                           `json:"bunqto_expiry"`
+	BunqtoTimeResponded          Time                           `json:"bunqto_time_responded"`
+	Attachment                   []MonetaryAccountAttachment    `json:"monetaryAccountAttachment"`
 	MerchantReference            string                         `json:"merchant_reference"`
 	BatchID                      int                            `json:"batch_id"`
 	ScheduledID                  int                            `json:"scheduled_id"`
-	AddressShipping              address                        `json:"address_shipping"`
-	AddressBilling               address                        `json:"address_billing"`
-	Geolocation                  geolocation                    `json:"geolocation"`
+	AddressShipping              Address                        `json:"address_shipping"`
+	AddressBilling               Address                        `json:"address_billing"`
+	Geolocation                  Geolocation                    `json:"geolocation"`
 	AllowChat                    bool                           `json:"allow_chat"`
-	RequestReferenceSplitTheBill []requestReferenceSplitTheBill `json:"request_reference_split_the_bill"`
+	RequestReferenceSplitTheBill []RequestReferenceSplitTheBill `json:"request_reference_split_the_bill"`
 	BalanceAfterMutation         Amount                         `json:"balance_after_mutation"`
 }
 
 // IsIncoming checks if the payment amount is positive, indicating an incoming payment.
 // Returns true if the payment is incoming, false otherwise.
 func (p *Payment) IsIncoming() bool {
-	return p.Amount.Decimal.IsPositive()
+	return p.Amount.IsPositive()
 }
 
 // IsOutgoing checks if the payment amount is negative, indicating an outgoing payment.
 // Returns true if the payment is outgoing, false otherwise.
 func (p *Payment) IsOutgoing() bool {
-	return p.Amount.Decimal.IsNegative()
+	return p.Amount.IsNegative()
 }
 
 // GetDirection determines the direction of the payment (incoming or outgoing) based on its amount.
@@ -502,14 +554,15 @@ type PaymentBatch struct {
 
 // ScheduledPayment The scheduled payment
 type ScheduledPayment struct {
-	common
+	Common
 	MonetaryAccountID int                   `json:"monetary_account_id"`
-	Payment           scheduledPaymentEntry `json:"payment"`
-	Schedule          schedule              `json:"schedule"`
+	Payment           ScheduledPaymentEntry `json:"payment"`
+	Schedule          Schedule              `json:"schedule"`
 	Status            string                `json:"status"`
 }
 
-type scheduledPaymentEntry struct {
+// ScheduledPaymentEntry The payment that is scheduled.
+type ScheduledPaymentEntry struct {
 	Amount            Amount               `json:"amount"`
 	Alias             LabelMonetaryAccount `json:"alias"`
 	CounterpartyAlias LabelMonetaryAccount `json:"counterparty_alias"`
@@ -518,54 +571,127 @@ type scheduledPaymentEntry struct {
 	AllowBunqTo       bool                 `json:"allow_bunqto"`
 }
 
-type schedule struct {
-	TimeStart      string               `json:"time_start"`
-	TimeEnd        string               `json:"time_end"`
+// Schedule When a scheduled payment is made.
+type Schedule struct {
+	TimeStart      Time                 `json:"time_start"`
+	TimeEnd        Time                 `json:"time_end"`
 	RecurrenceUnit string               `json:"recurrence_unit"`
 	RecurrenceSize int                  `json:"recurrence_size"`
 	Status         string               `json:"status"`
-	Object         scheduleAnchorObject `json:"object"`
+	Object         ScheduleAnchorObject `json:"object"`
 }
 
-type scheduleAnchorObject struct {
+// ScheduleAnchorObject The payment or payment batch a schedule is anchored to.
+type ScheduleAnchorObject struct {
 	Payment      Payment      `json:"payment"`
 	PaymentBatch PaymentBatch `json:"paymentBatch"`
 }
 
-type bunqMe struct {
+// BunqMe The bunq.me alias of a monetary account.
+type BunqMe struct {
 	Type  string `json:"type"`
 	Value string `json:"value"`
 	Name  string `json:"name"`
 }
 
-type monetaryAccountAttachment struct {
+// MonetaryAccountAttachment An attachment of a payment.
+type MonetaryAccountAttachment struct {
 	ID                int `json:"id"`
 	MonetaryAccountID int `json:"monetary_account_id"`
 }
 
-type geolocation struct {
+// Geolocation The location a payment was made at.
+type Geolocation struct {
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
 	Altitude  float64 `json:"altitude"`
 	Radius    float64 `json:"radius"`
 }
 
-type requestReferenceSplitTheBill struct {
+// RequestReferenceSplitTheBill A request that was made to split the bill of a payment.
+type RequestReferenceSplitTheBill struct {
 	Type string `json:"type"`
 	ID   int    `json:"id"`
 }
 
-type RequestResponse struct {
-	common
-	SubType           string               `json:"sub_type"`
+// RequestInquiry A request for money sent to someone else.
+type RequestInquiry struct {
+	Common
+	TimeResponded     Time                 `json:"time_responded"`
+	TimeExpiry        Time                 `json:"time_expiry"`
 	MonetaryAccountID int                  `json:"monetary_account_id"`
-	Amount            Amount               `json:"amount"`
-	AmountResponded   Amount               `json:"amount_responded"`
 	AmountInquired    Amount               `json:"amount_inquired"`
-	Alias             LabelMonetaryAccount `json:"alias"`
+	AmountResponded   Amount               `json:"amount_responded"`
+	UserAliasCreated  LabelUser            `json:"user_alias_created"`
+	UserAliasRevoked  LabelUser            `json:"user_alias_revoked"`
 	CounterpartyAlias LabelMonetaryAccount `json:"counterparty_alias"`
 	Description       string               `json:"description"`
-	CreditSchemeID    string               `json:"credit_scheme_identifier"`
-	MandateID         string               `json:"mandate_identifier"`
-	Responded         string               `json:"time_responded"`
+	MerchantReference string               `json:"merchant_reference"`
+	Status            string               `json:"status"`
+	BatchID           int                  `json:"batch_id"`
+	ScheduledID       int                  `json:"scheduled_id"`
+	MinimumAge        int                  `json:"minimum_age"`
+	RequireAddress    string               `json:"require_address"`
+	BunqmeShareURL    string               `json:"bunqme_share_url"`
+	RedirectURL       string               `json:"redirect_url"`
+}
+
+// RequestResponse A request for money received by the user, like a direct debit.
+type RequestResponse struct {
+	Common
+	SubType             string               `json:"sub_type"`
+	MonetaryAccountID   int                  `json:"monetary_account_id"`
+	Amount              Amount               `json:"amount"`
+	AmountResponded     Amount               `json:"amount_responded"`
+	AmountInquired      Amount               `json:"amount_inquired"`
+	Alias               LabelMonetaryAccount `json:"alias"`
+	CounterpartyAlias   LabelMonetaryAccount `json:"counterparty_alias"`
+	Description         string               `json:"description"`
+	Status              string               `json:"status"`
+	CreditSchemeID      string               `json:"credit_scheme_identifier"`
+	MandateID           string               `json:"mandate_identifier"`
+	Responded           Time                 `json:"time_responded"`
+	Type                string               `json:"type"`
+	EligibleWhitelistID int                  `json:"eligible_whitelist_id"`
+}
+
+// BunqMeTab A bunq.me tab, a payment link for a fixed amount that anyone can pay.
+type BunqMeTab struct {
+	Common
+	TimeExpiry        Time                     `json:"time_expiry"`
+	MonetaryAccountID int                      `json:"monetary_account_id"`
+	Status            string                   `json:"status"`
+	ShareURL          string                   `json:"bunqme_tab_share_url"`
+	Entry             BunqMeTabEntry           `json:"bunqme_tab_entry"`
+	ResultInquiries   []BunqMeTabResultInquiry `json:"result_inquiries"`
+}
+
+// BunqMeTabEntry The amount, description and receiving account of a bunq.me tab.
+type BunqMeTabEntry struct {
+	UUID           string               `json:"uuid"`
+	AmountInquired Amount               `json:"amount_inquired"`
+	Alias          LabelMonetaryAccount `json:"alias"`
+	Description    string               `json:"description"`
+	Status         string               `json:"status"`
+	RedirectURL    string               `json:"redirect_url"`
+}
+
+// BunqMeTabResultInquiry A payment received through a bunq.me tab.
+type BunqMeTabResultInquiry struct {
+	Payment     Payment `json:"payment"`
+	BunqMeTabID int     `json:"bunq_me_tab_id"`
+}
+
+// BunqMeFundraiserProfile A bunq.me fundraiser profile, a payment link for any amount to a monetary account.
+type BunqMeFundraiserProfile struct {
+	Common
+	MonetaryAccountID int                  `json:"monetary_account_id"`
+	Color             string               `json:"color"`
+	Currency          string               `json:"currency"`
+	Alias             LabelMonetaryAccount `json:"alias"`
+	Description       string               `json:"description"`
+	Pointer           BunqMe               `json:"pointer"`
+	RedirectURL       string               `json:"redirect_url"`
+	Status            string               `json:"status"`
+	ShareURL          string               `json:"share_url"`
 }
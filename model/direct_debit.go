@@ -0,0 +1,163 @@
+package model
+
+import (
+	"fmt"
+)
+
+// The statuses of a RequestResponse.
+const (
+	RequestResponseStatusPending  = "PENDING"
+	RequestResponseStatusAccepted = "ACCEPTED"
+	RequestResponseStatusRejected = "REJECTED"
+)
+
+// The types of a RequestResponse that is a SEPA direct debit.
+const (
+	RequestResponseTypeDirectDebit    = "DIRECT_DEBIT"
+	RequestResponseTypeDirectDebitB2B = "DIRECT_DEBIT_B2B"
+)
+
+// IsDirectDebit reports whether the request is a SEPA direct debit.
+func (r RequestResponse) IsDirectDebit() bool {
+	return r.Type == RequestResponseTypeDirectDebit || r.Type == RequestResponseTypeDirectDebitB2B
+}
+
+// DirectDebitMandate A SEPA direct debit mandate a creditor collects money from an account with.
+// bunq doesn't list mandates, they are derived from the direct debits collected under them by DirectDebitMandates.
+type DirectDebitMandate struct {
+	CreditSchemeID  string
+	MandateID       string
+	Type            string
+	Counterparty    LabelMonetaryAccount
+	Collections     int
+	FirstCollection Time
+	LastCollection  Time
+	LastAmount      Amount
+	LastStatus      string
+}
+
+// Active reports whether the last direct debit of the mandate was not rejected.
+func (m DirectDebitMandate) Active() bool {
+	return m.LastStatus != RequestResponseStatusRejected
+}
+
+// DirectDebitMandates returns the mandates of the direct debits among the given requests, one per creditor
+// and mandate, in the order of their last direct debit, newest first.
+func DirectDebitMandates(responses []RequestResponse) []DirectDebitMandate {
+	var mandates []DirectDebitMandate
+	index := map[[2]string]int{}
+
+	for _, r := range responses {
+		if !r.IsDirectDebit() {
+			continue
+		}
+
+		key := [2]string{r.CreditSchemeID, r.MandateID}
+		i, ok := index[key]
+		if !ok {
+			i = len(mandates)
+			index[key] = i
+			mandates = append(mandates, DirectDebitMandate{
+				CreditSchemeID: r.CreditSchemeID,
+				MandateID:      r.MandateID,
+				Type:           r.Type,
+				Counterparty:   r.CounterpartyAlias,
+			})
+		}
+
+		m := &mandates[i]
+		m.Collections++
+		if m.FirstCollection.IsZero() || r.Created.Before(m.FirstCollection.Time) {
+			m.FirstCollection = r.Created
+		}
+		if m.LastCollection.IsZero() || r.Created.After(m.LastCollection.Time) {
+			m.LastCollection = r.Created
+			m.LastAmount = r.AmountInquired
+			m.LastStatus = r.Status
+		}
+	}
+
+	return mandates
+}
+
+// SddWhitelist A creditor that may collect SEPA direct debits from an account, which bunq then accepts
+// without asking the user, up to the maximum amount per month.
+type SddWhitelist struct {
+	Common
+	MonetaryAccountIncomingID int                  `json:"monetary_account_incoming_id"`
+	MonetaryAccountPayingID   int                  `json:"monetary_account_paying_id"`
+	Type                      string               `json:"type"`
+	Status                    string               `json:"status"`
+	CreditSchemeID            string               `json:"credit_scheme_identifier"`
+	CounterpartyAlias         LabelMonetaryAccount `json:"counterparty_alias"`
+	MaximumAmountPerMonth     Amount               `json:"maximum_amount_per_month"`
+	UserAliasCreated          LabelUser            `json:"user_alias_created"`
+}
+
+// DirectDebitRules decide which pending direct debits are accepted and which are rejected.
+// A direct debit of a blocked creditor is rejected. Otherwise, it is accepted if its creditor is allowed,
+// or all are when AllowedCreditorIDs is empty, and its amount is at most MaxAmount, if set.
+// A direct debit that isn't accepted is rejected if RejectUnmatched is set, and left pending otherwise.
+type DirectDebitRules struct {
+	MaxAmount          *Amount
+	AllowedCreditorIDs []string
+	BlockedCreditorIDs []string
+	RejectUnmatched    bool
+}
+
+// DirectDebitDecision The status the rules decided on for a direct debit and why.
+// An empty status means the direct debit is left pending.
+type DirectDebitDecision struct {
+	RequestResponse RequestResponse
+	Status          string
+	Reason          string
+}
+
+// Decide returns the decision of the rules for the given request. Requests that are
+// not pending direct debits are left as they are.
+func (d DirectDebitRules) Decide(r RequestResponse) DirectDebitDecision {
+	decision := DirectDebitDecision{RequestResponse: r}
+
+	if !r.IsDirectDebit() || r.Status != RequestResponseStatusPending {
+		decision.Reason = "not a pending direct debit"
+		return decision
+	}
+
+	if contains(d.BlockedCreditorIDs, r.CreditSchemeID) {
+		decision.Status = RequestResponseStatusRejected
+		decision.Reason = fmt.Sprintf("creditor %s is blocked", r.CreditSchemeID)
+		return decision
+	}
+
+	reason := ""
+	if len(d.AllowedCreditorIDs) > 0 && !contains(d.AllowedCreditorIDs, r.CreditSchemeID) {
+		reason = fmt.Sprintf("creditor %s is not allowed", r.CreditSchemeID)
+	} else if d.MaxAmount != nil {
+		if cmp, err := r.AmountInquired.Cmp(*d.MaxAmount); err != nil || cmp > 0 {
+			reason = fmt.Sprintf("amount %s exceeds %s", r.AmountInquired, d.MaxAmount)
+		}
+	}
+
+	switch {
+	case reason == "":
+		decision.Status = RequestResponseStatusAccepted
+		decision.Reason = "matches the rules"
+	case d.RejectUnmatched:
+		decision.Status = RequestResponseStatusRejected
+		decision.Reason = reason
+	default:
+		decision.Reason = reason
+	}
+
+	return decision
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+
+	return false
+}
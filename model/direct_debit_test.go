@@ -0,0 +1,37 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDirectDebitRulesDecide(t *testing.T) {
+	t.Parallel()
+
+	debit := func(creditor, amount string) RequestResponse {
+		return RequestResponse{
+			Type:           RequestResponseTypeDirectDebit,
+			Status:         RequestResponseStatusPending,
+			CreditSchemeID: creditor,
+			AmountInquired: MustParseAmount(amount),
+		}
+	}
+
+	maxAmount := MustParseAmount("50.00 EUR")
+	rules := DirectDebitRules{MaxAmount: &maxAmount, BlockedCreditorIDs: []string{"BLOCKED"}}
+
+	assert.Equal(t, RequestResponseStatusAccepted, rules.Decide(debit("ANY", "50.00 EUR")).Status)
+	assert.Equal(t, RequestResponseStatusRejected, rules.Decide(debit("BLOCKED", "1.00 EUR")).Status)
+	assert.Equal(t, "", rules.Decide(debit("ANY", "50.01 EUR")).Status)
+	assert.Equal(t, "", rules.Decide(debit("ANY", "20.00 USD")).Status, "other currencies exceed the maximum")
+
+	accepted := debit("ANY", "1.00 EUR")
+	accepted.Status = RequestResponseStatusAccepted
+	assert.Equal(t, "", rules.Decide(accepted).Status)
+	assert.Equal(t, "", rules.Decide(RequestResponse{Type: "IDEAL", Status: RequestResponseStatusPending}).Status)
+
+	rules = DirectDebitRules{AllowedCreditorIDs: []string{"KNOWN"}, RejectUnmatched: true}
+	assert.Equal(t, RequestResponseStatusAccepted, rules.Decide(debit("KNOWN", "999.00 EUR")).Status)
+	assert.Equal(t, RequestResponseStatusRejected, rules.Decide(debit("OTHER", "1.00 EUR")).Status)
+}
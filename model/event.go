@@ -0,0 +1,80 @@
+package model
+
+// The statuses of an Event.
+const (
+	EventStatusAwaitingReply = "AWAITING_REPLY"
+	EventStatusFinalized     = "FINALIZED"
+)
+
+// Event An entry of the event feed of a user: something that happened to one of its objects, like a
+// payment that was made or a request that was received.
+type Event struct {
+	Common
+	Action            string      `json:"action"`
+	UserID            int         `json:"user_id"`
+	MonetaryAccountID int         `json:"monetary_account_id"`
+	Object            EventObject `json:"object"`
+	Status            string      `json:"status"`
+}
+
+// EventObject holds the object of an event, as returned by bunq under the key of its type.
+// Objects of other types than those below are not decoded.
+type EventObject struct {
+	Payment          *Payment          `json:"Payment,omitempty"`
+	PaymentBatch     *PaymentBatch     `json:"PaymentBatch,omitempty"`
+	DraftPayment     *DraftPayment     `json:"DraftPayment,omitempty"`
+	ScheduledPayment *ScheduledPayment `json:"ScheduledPayment,omitempty"`
+	MasterCardAction *MasterCardAction `json:"MasterCardAction,omitempty"`
+	RequestInquiry   *RequestInquiry   `json:"RequestInquiry,omitempty"`
+	RequestResponse  *RequestResponse  `json:"RequestResponse,omitempty"`
+	BunqMeTab        *BunqMeTab        `json:"BunqMeTab,omitempty"`
+}
+
+// Object returns the object the event holds, a pointer to one of the types of EventObject,
+// or nil if it holds none or one of another type.
+func (o EventObject) Object() interface{} {
+	switch {
+	case o.Payment != nil:
+		return o.Payment
+	case o.PaymentBatch != nil:
+		return o.PaymentBatch
+	case o.DraftPayment != nil:
+		return o.DraftPayment
+	case o.ScheduledPayment != nil:
+		return o.ScheduledPayment
+	case o.MasterCardAction != nil:
+		return o.MasterCardAction
+	case o.RequestInquiry != nil:
+		return o.RequestInquiry
+	case o.RequestResponse != nil:
+		return o.RequestResponse
+	case o.BunqMeTab != nil:
+		return o.BunqMeTab
+	}
+
+	return nil
+}
+
+// Type returns the key of the type of the object bunq uses, like "Payment", or "" if the object is not decoded.
+func (o EventObject) Type() string {
+	switch o.Object().(type) {
+	case *Payment:
+		return "Payment"
+	case *PaymentBatch:
+		return "PaymentBatch"
+	case *DraftPayment:
+		return "DraftPayment"
+	case *ScheduledPayment:
+		return "ScheduledPayment"
+	case *MasterCardAction:
+		return "MasterCardAction"
+	case *RequestInquiry:
+		return "RequestInquiry"
+	case *RequestResponse:
+		return "RequestResponse"
+	case *BunqMeTab:
+		return "BunqMeTab"
+	}
+
+	return ""
+}
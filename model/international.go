@@ -0,0 +1,95 @@
+package model
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The order types of a currency conversion quote: buying the amount in the target currency,
+// or selling the amount in the source currency.
+const (
+	CurrencyConversionOrderTypeBuy  = "BUY"
+	CurrencyConversionOrderTypeSell = "SELL"
+)
+
+// CurrencyConversionQuoteStatusAccepted is the status of an accepted currency conversion quote.
+const CurrencyConversionQuoteStatusAccepted = "ACCEPTED"
+
+// CurrencyConversionQuote A quote to convert money between accounts of the user in different currencies.
+// The quote has to be accepted before it expires.
+type CurrencyConversionQuote struct {
+	Common
+	Status            string               `json:"status"`
+	OrderType         string               `json:"order_type"`
+	AmountSource      Amount               `json:"amount_source"`
+	AmountTarget      Amount               `json:"amount_target"`
+	AmountFee         Amount               `json:"amount_fee"`
+	Rate              string               `json:"rate"`
+	CounterpartyAlias LabelMonetaryAccount `json:"counterparty_alias"`
+	TimeExpiry        Time                 `json:"time_expiry"`
+}
+
+// Expired reports whether the quote expired at t.
+func (q CurrencyConversionQuote) Expired(t time.Time) bool {
+	return !q.TimeExpiry.IsZero() && !t.Before(q.TimeExpiry.Time)
+}
+
+// TransferwiseQuote A quote of Wise (formerly Transferwise) for an international transfer, with its rate and fee.
+// A transfer has to be created for it before it expires.
+type TransferwiseQuote struct {
+	Common
+	QuoteID              string `json:"quote_id"`
+	AmountSource         Amount `json:"amount_source"`
+	AmountTarget         Amount `json:"amount_target"`
+	AmountFee            Amount `json:"amount_fee"`
+	Rate                 string `json:"rate"`
+	TimeExpiry           Time   `json:"time_expiry"`
+	TimeDeliveryEstimate Time   `json:"time_delivery_estimate"`
+}
+
+// Expired reports whether the quote expired at t.
+func (q TransferwiseQuote) Expired(t time.Time) bool {
+	return !q.TimeExpiry.IsZero() && !t.Before(q.TimeExpiry.Time)
+}
+
+// TransferwiseTransfer An international transfer through Wise. Status is the status at bunq,
+// StatusTransferwise the one at Wise, like "processing" or "outgoing_payment_sent".
+type TransferwiseTransfer struct {
+	Common
+	MonetaryAccountID       int                  `json:"monetary_account_id"`
+	RecipientID             string               `json:"recipient_id"`
+	Alias                   LabelMonetaryAccount `json:"alias"`
+	CounterpartyAlias       LabelMonetaryAccount `json:"counterparty_alias"`
+	Status                  string               `json:"status"`
+	SubStatus               string               `json:"sub_status"`
+	StatusTransferwise      string               `json:"status_transferwise"`
+	StatusTransferwiseIssue string               `json:"status_transferwise_issue"`
+	AmountSource            Amount               `json:"amount_source"`
+	AmountTarget            Amount               `json:"amount_target"`
+	Rate                    string               `json:"rate"`
+	Reference               string               `json:"reference"`
+	PayInReference          string               `json:"pay_in_reference"`
+	TimeDeliveryEstimate    Time                 `json:"time_delivery_estimate"`
+	Quote                   TransferwiseQuote    `json:"quote"`
+}
+
+// NewTransferwiseRecipient returns the recipient of an international transfer to the given counterparty,
+// with the Wise account number and bank code bunq sent for it, e.g. as the counterparty of an earlier payment.
+func NewTransferwiseRecipient(counterparty LabelMonetaryAccount, country, recipientType string) (TransferwiseRecipientCreate, error) {
+	if counterparty.TransferwiseAccountNumber == "" {
+		return TransferwiseRecipientCreate{}, errors.Errorf("bunq: %q has no Wise account number", counterparty.DisplayName)
+	}
+
+	detail := []TransferwiseRecipientDetail{{Key: "accountNumber", Value: counterparty.TransferwiseAccountNumber}}
+	if counterparty.TransferwiseBankCode != "" {
+		detail = append(detail, TransferwiseRecipientDetail{Key: "bankCode", Value: counterparty.TransferwiseBankCode})
+	}
+
+	return TransferwiseRecipientCreate{
+		Country:           country,
+		NameAccountHolder: counterparty.DisplayName,
+		Type:              recipientType,
+		Detail:            detail,
+	}, nil
+}
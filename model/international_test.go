@@ -0,0 +1,80 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyConversionQuoteCreateValidate(t *testing.T) {
+	t.Parallel()
+
+	to, err := IBANPointer("NL61BUNQ2290361512", "USD account")
+	require.NoError(t, err)
+
+	sell := CurrencyConversionQuoteCreate{
+		Amount:            MustParseAmount("100.00 EUR"),
+		CurrencySource:    "EUR",
+		CurrencyTarget:    "USD",
+		OrderType:         CurrencyConversionOrderTypeSell,
+		CounterpartyAlias: to,
+	}
+	assert.NoError(t, sell.Validate())
+
+	buy := sell
+	buy.OrderType = CurrencyConversionOrderTypeBuy
+	assert.Error(t, buy.Validate(), "a buy order is in the target currency")
+	buy.Amount = MustParseAmount("100.00 USD")
+	assert.NoError(t, buy.Validate())
+
+	same := sell
+	same.CurrencyTarget = "EUR"
+	assert.Error(t, same.Validate())
+
+	noType := sell
+	noType.OrderType = ""
+	assert.Error(t, noType.Validate())
+}
+
+func TestTransferwiseQuoteCreateValidate(t *testing.T) {
+	t.Parallel()
+
+	source := MustParseAmount("500.00 EUR")
+	target := MustParseAmount("400.00 GBP")
+
+	assert.NoError(t, TransferwiseQuoteCreate{CurrencySource: "EUR", CurrencyTarget: "GBP", AmountSource: &source}.Validate())
+	assert.NoError(t, TransferwiseQuoteCreate{CurrencySource: "EUR", CurrencyTarget: "GBP", AmountTarget: &target}.Validate())
+	assert.Error(t, TransferwiseQuoteCreate{CurrencySource: "EUR", CurrencyTarget: "GBP"}.Validate())
+	assert.Error(t, TransferwiseQuoteCreate{CurrencySource: "EUR", CurrencyTarget: "GBP", AmountSource: &source, AmountTarget: &target}.Validate())
+	assert.Error(t, TransferwiseQuoteCreate{CurrencySource: "EUR", CurrencyTarget: "GBP", AmountSource: &target}.Validate())
+}
+
+func TestQuoteExpired(t *testing.T) {
+	t.Parallel()
+
+	expiry := NewTime(time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC))
+	q := TransferwiseQuote{TimeExpiry: expiry}
+
+	assert.False(t, q.Expired(expiry.Add(-time.Second)))
+	assert.True(t, q.Expired(expiry.Time))
+	assert.False(t, TransferwiseQuote{}.Expired(time.Now()))
+	assert.True(t, CurrencyConversionQuote{TimeExpiry: expiry}.Expired(time.Now()))
+}
+
+func TestNewTransferwiseRecipient(t *testing.T) {
+	t.Parallel()
+
+	recipient, err := NewTransferwiseRecipient(LabelMonetaryAccount{
+		DisplayName:               "Brompton Parts Ltd",
+		TransferwiseAccountNumber: "31926819",
+		TransferwiseBankCode:      "231470",
+	}, "GB", "sort_code")
+	require.NoError(t, err)
+	assert.Equal(t, "Brompton Parts Ltd", recipient.NameAccountHolder)
+	assert.Equal(t, []TransferwiseRecipientDetail{{Key: "accountNumber", Value: "31926819"}, {Key: "bankCode", Value: "231470"}}, recipient.Detail)
+
+	_, err = NewTransferwiseRecipient(LabelMonetaryAccount{DisplayName: "Grace Hopper", IBAN: "NL12BUNQ2025415389"}, "NL", "iban")
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"math/big"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The types of a Pointer.
+const (
+	PointerTypeIBAN        = "IBAN"
+	PointerTypeEmail       = "EMAIL"
+	PointerTypePhoneNumber = "PHONE_NUMBER"
+)
+
+// ErrInvalidPointer is returned for pointers bunq would reject.
+var ErrInvalidPointer = errors.New("bunq: invalid pointer")
+
+// ibanLengths holds the length of the IBANs of each country.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BR": 29,
+	"BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "DO": 28, "EE": 20, "EG": 29,
+	"ES": 24, "FI": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28,
+	"HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20,
+	"LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "MC": 27, "MD": 24, "ME": 22, "MK": 19,
+	"MR": 27, "MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29,
+	"RO": 24, "RS": 22, "SA": 24, "SC": 31, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+}
+
+var (
+	ibanPattern = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+	// e164Pattern matches phone numbers in the international E.164 format.
+	e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+)
+
+// IBANPointer creates an IBAN pointer. The IBAN is normalised, so it may contain spaces and lowercase letters.
+// bunq requires the name of the account holder for IBAN pointers.
+func IBANPointer(iban, name string) (Pointer, error) {
+	p := Pointer{PType: PointerTypeIBAN, Value: NormalizeIBAN(iban), Name: &name}
+
+	return p, p.Validate()
+}
+
+// EmailPointer creates an email pointer.
+func EmailPointer(email string) (Pointer, error) {
+	p := Pointer{PType: PointerTypeEmail, Value: strings.TrimSpace(email)}
+
+	return p, p.Validate()
+}
+
+// PhoneNumberPointer creates a phone number pointer.
+// The number must be in the international format, spaces, dashes, dots and parentheses are removed.
+func PhoneNumberPointer(phoneNumber string) (Pointer, error) {
+	p := Pointer{PType: PointerTypePhoneNumber, Value: normalizePhoneNumber(phoneNumber)}
+
+	return p, p.Validate()
+}
+
+// Validate checks the value of IBAN, email and phone number pointers.
+// Pointers of other types are not checked.
+func (p Pointer) Validate() error {
+	switch p.PType {
+	case PointerTypeIBAN:
+		if err := ValidateIBAN(p.Value); err != nil {
+			return err
+		}
+		if p.Name == nil || strings.TrimSpace(*p.Name) == "" {
+			return errors.Wrap(ErrInvalidPointer, "IBAN pointers require a name")
+		}
+	case PointerTypeEmail:
+		addr, err := mail.ParseAddress(p.Value)
+		if err != nil || addr.Address != p.Value {
+			return errors.Wrapf(ErrInvalidPointer, "invalid email address %q", p.Value)
+		}
+	case PointerTypePhoneNumber:
+		if !e164Pattern.MatchString(p.Value) {
+			return errors.Wrapf(ErrInvalidPointer, "invalid phone number %q, expected the international format like +31612345678", p.Value)
+		}
+	case "":
+		return errors.Wrap(ErrInvalidPointer, "missing type")
+	}
+
+	return nil
+}
+
+// NormalizeIBAN removes the spaces from an IBAN and converts it to uppercase.
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+}
+
+// ValidateIBAN checks the country, length and checksum of a normalised IBAN.
+func ValidateIBAN(iban string) error {
+	if !ibanPattern.MatchString(iban) {
+		return errors.Wrapf(ErrInvalidPointer, "invalid IBAN %q", iban)
+	}
+
+	length, ok := ibanLengths[iban[:2]]
+	if !ok {
+		return errors.Wrapf(ErrInvalidPointer, "invalid IBAN %q, unknown country %s", iban, iban[:2])
+	}
+	if len(iban) != length {
+		return errors.Wrapf(ErrInvalidPointer, "invalid IBAN %q, %s IBANs have %d characters", iban, iban[:2], length)
+	}
+
+	// Move the country and check digits to the end and replace the letters by numbers, A = 10 to Z = 35.
+	var digits strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			digits.WriteString(strconv.Itoa(int(r-'A') + 10))
+		} else {
+			digits.WriteRune(r)
+		}
+	}
+
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	if new(big.Int).Mod(n, big.NewInt(97)).Int64() != 1 {
+		return errors.Wrapf(ErrInvalidPointer, "invalid IBAN %q, wrong checksum", iban)
+	}
+
+	return nil
+}
+
+func normalizePhoneNumber(phoneNumber string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, phoneNumber)
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIBANPointer(t *testing.T) {
+	t.Parallel()
+
+	p, err := IBANPointer("nl85 bunq 9900 1006 11", "Donald Cadieux")
+	require.NoError(t, err)
+	assert.Equal(t, PointerTypeIBAN, p.PType)
+	assert.Equal(t, "NL85BUNQ9900100611", p.Value)
+	assert.Equal(t, "Donald Cadieux", *p.Name)
+
+	for _, iban := range []string{"NL09BUNQ9900000420", "DE89370400440532013000", "GB82WEST12345698765432", "BE68539007547034"} {
+		assert.NoError(t, ValidateIBAN(iban), iban)
+	}
+
+	for _, iban := range []string{"", "NL86BUNQ9900100611", "NL85BUNQ990010061", "XX85BUNQ9900100611", "NL85-BUNQ-9900100611", "8585BUNQ9900100611"} {
+		_, err := IBANPointer(iban, "Donald Cadieux")
+		assert.ErrorIs(t, err, ErrInvalidPointer, iban)
+	}
+
+	_, err = IBANPointer("NL85BUNQ9900100611", " ")
+	assert.ErrorIs(t, err, ErrInvalidPointer)
+}
+
+func TestEmailPointer(t *testing.T) {
+	t.Parallel()
+
+	p, err := EmailPointer(" donald.cadieux@bunq.org ")
+	require.NoError(t, err)
+	assert.Equal(t, Pointer{PType: PointerTypeEmail, Value: "donald.cadieux@bunq.org"}, p)
+
+	for _, email := range []string{"", "donald", "donald@", "Donald <donald.cadieux@bunq.org>", "donald cadieux@bunq.org"} {
+		_, err := EmailPointer(email)
+		assert.ErrorIs(t, err, ErrInvalidPointer, email)
+	}
+}
+
+func TestPhoneNumberPointer(t *testing.T) {
+	t.Parallel()
+
+	p, err := PhoneNumberPointer("+31 (6) 4466-2311")
+	require.NoError(t, err)
+	assert.Equal(t, Pointer{PType: PointerTypePhoneNumber, Value: "+31644662311"}, p)
+
+	for _, phone := range []string{"", "0644662311", "+0644662311", "+3164466231a", "+31", "+3164466231100000000"} {
+		_, err := PhoneNumberPointer(phone)
+		assert.ErrorIs(t, err, ErrInvalidPointer, phone)
+	}
+}
+
+func TestPointerValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.ErrorIs(t, Pointer{Value: "+31644662311"}.Validate(), ErrInvalidPointer)
+	assert.ErrorIs(t, Pointer{PType: PointerTypeIBAN, Value: "NL85BUNQ9900100611"}.Validate(), ErrInvalidPointer)
+	assert.NoError(t, Pointer{PType: "URL", Value: "https://bunq.me/donald"}.Validate())
+
+	batch := PaymentBatchCreate{Payments: []PaymentCreate{
+		{CounterpartyAlias: Pointer{PType: PointerTypeEmail, Value: "donald.cadieux@bunq.org"}},
+		{CounterpartyAlias: Pointer{PType: PointerTypeEmail, Value: "donald"}},
+	}}
+	err := batch.Validate()
+	assert.ErrorIs(t, err, ErrInvalidPointer)
+	assert.Contains(t, err.Error(), "payment 1")
+}
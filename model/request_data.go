@@ -1,5 +1,11 @@
 package model
 
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
 type RequestInstallation struct {
 	ClientPublicKey string `json:"client_public_key"`
 }
@@ -18,6 +24,29 @@ type RequestUserPersonPut struct {
 	NotificationFilters []NotificationFilter `json:"notification_filters,omitempty"`
 }
 
+// RequestUserCompanyPut The fields of a user company to update, fields that are not set are left unchanged.
+type RequestUserCompanyPut struct {
+	Name                string               `json:"name,omitempty"`
+	PublicNickName      string               `json:"public_nick_name,omitempty"`
+	AddressMain         *Address             `json:"address_main,omitempty"`
+	AddressPostal       *Address             `json:"address_postal,omitempty"`
+	Language            string               `json:"language,omitempty"`
+	Region              string               `json:"region,omitempty"`
+	SessionTimeout      int64                `json:"session_timeout,omitempty"`
+	NotificationFilters []NotificationFilter `json:"notification_filters,omitempty"`
+}
+
+// RequestNotificationFilterURL replaces all URL callbacks of a user or monetary account.
+type RequestNotificationFilterURL struct {
+	NotificationFilters []NotificationFilterURLCreate `json:"notification_filters"`
+}
+
+// NotificationFilterURLCreate A URL callback to create.
+type NotificationFilterURLCreate struct {
+	Category           NotificationCategory `json:"category"`
+	NotificationTarget string               `json:"notification_target"`
+}
+
 type RequestCreateDraftPayment struct {
 	Entries                 []DraftPaymentEntryCreate `json:"entries"`
 	NumberOfRequiredAccepts *int                      `json:"number_of_required_accepts,omitempty"`
@@ -25,7 +54,7 @@ type RequestCreateDraftPayment struct {
 
 type RequestUpdateDraftPayment struct {
 	RequestCreateDraftPayment
-	UpdatedTimestamp string `json:"previous_updated_timestamp"`
+	UpdatedTimestamp Time `json:"previous_updated_timestamp"`
 	status           *string
 }
 
@@ -46,3 +75,281 @@ type PaymentCreate struct {
 	Description       string  `json:"description"`
 	AllowBunqto       bool    `json:"allow_bunqto"`
 }
+
+// Validate checks the counterparty of the payment.
+func (p PaymentCreate) Validate() error {
+	return errors.Wrap(p.CounterpartyAlias.Validate(), "counterparty")
+}
+
+// Validate checks the counterparties of all payments of the batch.
+func (b PaymentBatchCreate) Validate() error {
+	for i, p := range b.Payments {
+		if err := p.Validate(); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("payment %d", i))
+		}
+	}
+
+	return nil
+}
+
+// Validate checks the counterparty of the entry.
+func (e DraftPaymentEntryCreate) Validate() error {
+	return errors.Wrap(e.CounterpartyAlias.Validate(), "counterparty")
+}
+
+// Validate checks the counterparties of all entries of the draft payment.
+func (r RequestCreateDraftPayment) Validate() error {
+	for i, e := range r.Entries {
+		if err := e.Validate(); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("entry %d", i))
+		}
+	}
+
+	return nil
+}
+
+// RequestOAuthClient The fields of an OAuth client to create or update.
+type RequestOAuthClient struct {
+	Status string `json:"status,omitempty"`
+}
+
+// RequestOAuthCallbackURL A callback URL to add to an OAuth client.
+type RequestOAuthCallbackURL struct {
+	URL string `json:"url"`
+}
+
+// RequestInquiryCreate A request for money to send to someone else.
+type RequestInquiryCreate struct {
+	AmountInquired    Amount  `json:"amount_inquired"`
+	CounterpartyAlias Pointer `json:"counterparty_alias"`
+	Description       string  `json:"description"`
+	AllowBunqme       bool    `json:"allow_bunqme"`
+	MerchantReference string  `json:"merchant_reference,omitempty"`
+	RedirectURL       string  `json:"redirect_url,omitempty"`
+}
+
+// Validate checks the counterparty of the request.
+func (r RequestInquiryCreate) Validate() error {
+	return errors.Wrap(r.CounterpartyAlias.Validate(), "counterparty")
+}
+
+// BunqMeTabCreate A bunq.me tab to create.
+type BunqMeTabCreate struct {
+	Entry BunqMeTabEntryCreate `json:"bunqme_tab_entry"`
+}
+
+// BunqMeTabEntryCreate The amount and description of a bunq.me tab to create, and where to send the payer afterwards.
+type BunqMeTabEntryCreate struct {
+	AmountInquired Amount `json:"amount_inquired"`
+	Description    string `json:"description"`
+	RedirectURL    string `json:"redirect_url,omitempty"`
+}
+
+// Validate checks that the tab asks for a positive amount.
+func (b BunqMeTabCreate) Validate() error {
+	if !b.Entry.AmountInquired.IsPositive() {
+		return errors.Errorf("bunq: the amount of a bunq.me tab must be positive, got %s", b.Entry.AmountInquired)
+	}
+
+	return nil
+}
+
+// BunqMeFundraiserProfileCreate A bunq.me fundraiser profile to create for a monetary account.
+type BunqMeFundraiserProfileCreate struct {
+	MonetaryAccountID int    `json:"monetary_account_id"`
+	Description       string `json:"description"`
+	RedirectURL       string `json:"redirect_url,omitempty"`
+}
+
+// RequestBunqMeStatus The status to set on a bunq.me tab or fundraiser profile, e.g. to close it.
+type RequestBunqMeStatus struct {
+	Status string `json:"status"`
+}
+
+// ShareInviteCreate A share invite to send for a monetary account. StartDate and EndDate are optional,
+// without them the access starts right away and doesn't end.
+type ShareInviteCreate struct {
+	CounterUserAlias Pointer     `json:"counter_user_alias"`
+	ShareDetail      ShareDetail `json:"share_detail"`
+	Status           string      `json:"status"`
+	StartDate        *Time       `json:"start_date,omitempty"`
+	EndDate          *Time       `json:"end_date,omitempty"`
+}
+
+// Validate checks the counterparty, the access and the budget of the share invite, and that it doesn't end before it starts.
+func (s ShareInviteCreate) Validate() error {
+	if err := s.CounterUserAlias.Validate(); err != nil {
+		return errors.Wrap(err, "counterparty")
+	}
+
+	if err := s.ShareDetail.validate(); err != nil {
+		return err
+	}
+
+	if s.StartDate != nil && s.EndDate != nil && !s.EndDate.After(s.StartDate.Time) {
+		return errors.Errorf("bunq: the end date of a share invite must be after its start date, got %s", s.EndDate)
+	}
+
+	return nil
+}
+
+// ShareInviteUpdate The fields of a share invite to update, fields that are not set are left unchanged.
+type ShareInviteUpdate struct {
+	ShareDetail *ShareDetail `json:"share_detail,omitempty"`
+	Status      string       `json:"status,omitempty"`
+	EndDate     *Time        `json:"end_date,omitempty"`
+}
+
+// Validate checks the access and the budget of the share invite, if set.
+func (s ShareInviteUpdate) Validate() error {
+	if s.ShareDetail == nil {
+		return nil
+	}
+
+	return s.ShareDetail.validate()
+}
+
+func (d ShareDetail) validate() error {
+	set := 0
+	for _, ok := range []bool{d.Payment != nil, d.DraftPayment != nil, d.ReadOnly != nil} {
+		if ok {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return errors.Errorf("bunq: a share invite must give exactly one type of access, got %d", set)
+	}
+
+	if d.Payment != nil && d.Payment.Budget != nil {
+		if !d.Payment.Budget.Amount.IsPositive() {
+			return errors.Errorf("bunq: the budget of a share invite must be positive, got %s", d.Payment.Budget.Amount)
+		}
+
+		if d.Payment.Budget.Frequency == "" {
+			return errors.New("bunq: the budget of a share invite needs a frequency")
+		}
+	}
+
+	return nil
+}
+
+// RequestShareInviteStatus The status to set on a share invite, e.g. to revoke, accept or reject it.
+type RequestShareInviteStatus struct {
+	Status string `json:"status"`
+}
+
+// SddWhitelistCreate Whitelists the creditor of a direct debit the user received, identified by RequestID,
+// for the paying account up to the maximum amount per month.
+type SddWhitelistCreate struct {
+	MonetaryAccountPayingID int    `json:"monetary_account_paying_id"`
+	RequestID               int    `json:"request_id"`
+	MaximumAmountPerMonth   Amount `json:"maximum_amount_per_month"`
+}
+
+// Validate checks that the maximum amount per month is positive.
+func (s SddWhitelistCreate) Validate() error {
+	if !s.MaximumAmountPerMonth.IsPositive() {
+		return errors.Errorf("bunq: the maximum amount per month of a whitelist must be positive, got %s", s.MaximumAmountPerMonth)
+	}
+
+	return nil
+}
+
+// RequestRequestResponseStatus The status to set on a request response, to accept or reject it.
+type RequestRequestResponseStatus struct {
+	Status string `json:"status"`
+}
+
+// CurrencyConversionQuoteCreate A quote to request for converting the amount, in the source currency when selling
+// or in the target currency when buying, to the account of the user that is the counterparty.
+type CurrencyConversionQuoteCreate struct {
+	Amount            Amount  `json:"amount"`
+	CurrencySource    string  `json:"currency_source"`
+	CurrencyTarget    string  `json:"currency_target"`
+	OrderType         string  `json:"order_type"`
+	CounterpartyAlias Pointer `json:"counterparty_alias"`
+}
+
+// Validate checks the amount, the currencies, the order type and the counterparty of the quote.
+func (c CurrencyConversionQuoteCreate) Validate() error {
+	if !c.Amount.IsPositive() {
+		return errors.Errorf("bunq: the amount of a currency conversion must be positive, got %s", c.Amount)
+	}
+
+	if !isCurrencyCode(c.CurrencySource) || !isCurrencyCode(c.CurrencyTarget) || c.CurrencySource == c.CurrencyTarget {
+		return errors.Errorf("bunq: cannot convert %q to %q", c.CurrencySource, c.CurrencyTarget)
+	}
+
+	currency := c.CurrencySource
+	switch c.OrderType {
+	case CurrencyConversionOrderTypeSell:
+	case CurrencyConversionOrderTypeBuy:
+		currency = c.CurrencyTarget
+	default:
+		return errors.Errorf("bunq: invalid order type %q", c.OrderType)
+	}
+
+	if c.Amount.Currency != currency {
+		return errors.Errorf("bunq: the amount of a %s order must be in %s, got %s", c.OrderType, currency, c.Amount)
+	}
+
+	return errors.Wrap(c.CounterpartyAlias.Validate(), "counterparty")
+}
+
+// RequestCurrencyConversionQuoteStatus The status to set on a currency conversion quote, to accept it.
+type RequestCurrencyConversionQuoteStatus struct {
+	Status string `json:"status"`
+}
+
+// TransferwiseQuoteCreate A quote to request from Wise for an international transfer. Exactly one of AmountSource,
+// the amount to pay, and AmountTarget, the amount the recipient gets, is set.
+type TransferwiseQuoteCreate struct {
+	CurrencySource string  `json:"currency_source"`
+	CurrencyTarget string  `json:"currency_target"`
+	AmountSource   *Amount `json:"amount_source,omitempty"`
+	AmountTarget   *Amount `json:"amount_target,omitempty"`
+}
+
+// Validate checks the currencies and the amount of the quote.
+func (t TransferwiseQuoteCreate) Validate() error {
+	if !isCurrencyCode(t.CurrencySource) || !isCurrencyCode(t.CurrencyTarget) {
+		return errors.Errorf("bunq: cannot transfer %q to %q", t.CurrencySource, t.CurrencyTarget)
+	}
+
+	if (t.AmountSource == nil) == (t.AmountTarget == nil) {
+		return errors.New("bunq: a Wise quote needs either a source or a target amount")
+	}
+
+	amount, currency := t.AmountSource, t.CurrencySource
+	if amount == nil {
+		amount, currency = t.AmountTarget, t.CurrencyTarget
+	}
+
+	if !amount.IsPositive() || amount.Currency != currency {
+		return errors.Errorf("bunq: the amount of a Wise quote must be positive and in %s, got %s", currency, amount)
+	}
+
+	return nil
+}
+
+// TransferwiseRecipientCreate The recipient of an international transfer. The keys of the detail depend on
+// the currency and the type of the recipient, see NewTransferwiseRecipient.
+type TransferwiseRecipientCreate struct {
+	Country           string                        `json:"country"`
+	NameAccountHolder string                        `json:"name_account_holder"`
+	Type              string                        `json:"type"`
+	Detail            []TransferwiseRecipientDetail `json:"detail"`
+}
+
+// TransferwiseRecipientDetail A field of the bank details of a Wise recipient.
+type TransferwiseRecipientDetail struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// TransferwiseTransferCreate An international transfer to create for a Wise quote, from the account to the recipient.
+type TransferwiseTransferCreate struct {
+	MonetaryAccountID int    `json:"monetary_account_id"`
+	RecipientID       string `json:"recipient_id"`
+}
@@ -1,29 +1,83 @@
 package model
 
+// ResponseInstallation The installation response object.
 type ResponseInstallation struct {
 	Response []Installation
 }
 
+// ResponseError The error response object.
 type ResponseError struct {
-	Error []bunqError `json:"Error"`
+	Error []BunqError `json:"Error"`
 }
 
+// ResponseDeviceServer The device server response object.
 type ResponseDeviceServer struct {
-	Response []wrappedBunqID
+	Response []WrappedBunqID
 }
 
+// ResponseSessionServer The session server response object.
 type ResponseSessionServer struct {
 	Response []SessionServer
 }
 
+// ResponseUserPerson The user person response object.
 type ResponseUserPerson struct {
 	Response []struct {
-		UserPerson userPerson
+		UserPerson UserPerson
 	}
 }
 
+// UserPerson returns the user person of the response, or the zero UserPerson if it holds none.
+func (r *ResponseUserPerson) UserPerson() UserPerson {
+	if len(r.Response) == 0 {
+		return UserPerson{}
+	}
+
+	return r.Response[0].UserPerson
+}
+
+// ResponseUserCompany The user company response object.
+type ResponseUserCompany struct {
+	Response []struct {
+		UserCompany UserCompany
+	}
+}
+
+// UserCompany returns the user company of the response, or the zero UserCompany if it holds none.
+func (r *ResponseUserCompany) UserCompany() UserCompany {
+	if len(r.Response) == 0 {
+		return UserCompany{}
+	}
+
+	return r.Response[0].UserCompany
+}
+
+// ResponseUser The user response object, holding a person, company or API key user.
+type ResponseUser struct {
+	Response []AnyUser
+}
+
+// User returns the user of the response, or nil if it holds none.
+func (r *ResponseUser) User() User {
+	if len(r.Response) == 0 {
+		return nil
+	}
+
+	return r.Response[0].User()
+}
+
+// ResponseBunqID The response of requests creating or updating an object.
 type ResponseBunqID struct {
-	Response []wrappedBunqID
+	Response []WrappedBunqID
+}
+
+// ID returns the id of the created or updated object, or 0 if the response holds none.
+func (r *ResponseBunqID) ID() int {
+	if len(r.Response) == 0 {
+		return 0
+	}
+
+	return r.Response[0].ID.ID
 }
 
 // ResponseMonetaryAccountBankGet The monetary account bank response object.
@@ -34,6 +88,16 @@ type ResponseMonetaryAccountBankGet struct {
 	Pagination Pagination `json:"Pagination"`
 }
 
+// MonetaryAccountBanks returns the monetary account banks of the response.
+func (r *ResponseMonetaryAccountBankGet) MonetaryAccountBanks() []MonetaryAccountBank {
+	monetaryAccountBanks := make([]MonetaryAccountBank, 0, len(r.Response))
+	for _, res := range r.Response {
+		monetaryAccountBanks = append(monetaryAccountBanks, res.MonetaryAccountBank)
+	}
+
+	return monetaryAccountBanks
+}
+
 // ResponseMonetaryAccountSavingGet The monetary account savings response object.
 type ResponseMonetaryAccountSavingGet struct {
 	Response []struct {
@@ -42,12 +106,33 @@ type ResponseMonetaryAccountSavingGet struct {
 	Pagination Pagination `json:"Pagination"`
 }
 
+// MonetaryAccountSavings returns the monetary account savings of the response.
+func (r *ResponseMonetaryAccountSavingGet) MonetaryAccountSavings() []MonetaryAccountSaving {
+	monetaryAccountSavings := make([]MonetaryAccountSaving, 0, len(r.Response))
+	for _, res := range r.Response {
+		monetaryAccountSavings = append(monetaryAccountSavings, res.MonetaryAccountSaving)
+	}
+
+	return monetaryAccountSavings
+}
+
+// ResponseDraftPaymentGet The draft payment response object.
 type ResponseDraftPaymentGet struct {
 	Response []struct {
-		DraftPayment draftPayment `json:"DraftPayment"`
+		DraftPayment DraftPayment `json:"DraftPayment"`
 	} `json:"Response"`
 }
 
+// DraftPayments returns the draft payments of the response.
+func (r *ResponseDraftPaymentGet) DraftPayments() []DraftPayment {
+	draftPayments := make([]DraftPayment, 0, len(r.Response))
+	for _, res := range r.Response {
+		draftPayments = append(draftPayments, res.DraftPayment)
+	}
+
+	return draftPayments
+}
+
 // ResponsePaymentGet The payment response data.
 type ResponsePaymentGet struct {
 	Response []struct {
@@ -56,13 +141,34 @@ type ResponsePaymentGet struct {
 	Pagination Pagination `json:"Pagination"`
 }
 
+// Payments returns the payments of the response.
+func (r *ResponsePaymentGet) Payments() []Payment {
+	payments := make([]Payment, 0, len(r.Response))
+	for _, res := range r.Response {
+		payments = append(payments, res.Payment)
+	}
+
+	return payments
+}
+
+// ResponseMasterCardActionGet The mastercard action response object.
 type ResponseMasterCardActionGet struct {
 	Response []struct {
-		MasterCardAction masterCardAction `json:"MasterCardAction"`
+		MasterCardAction MasterCardAction `json:"MasterCardAction"`
 	} `json:"Response"`
 	Pagination Pagination `json:"Pagination"`
 }
 
+// MasterCardActions returns the mastercard actions of the response.
+func (r *ResponseMasterCardActionGet) MasterCardActions() []MasterCardAction {
+	masterCardActions := make([]MasterCardAction, 0, len(r.Response))
+	for _, res := range r.Response {
+		masterCardActions = append(masterCardActions, res.MasterCardAction)
+	}
+
+	return masterCardActions
+}
+
 // ResponseScheduledPaymentsGet The scheduled payments response object.
 type ResponseScheduledPaymentsGet struct {
 	Response []struct {
@@ -71,14 +177,305 @@ type ResponseScheduledPaymentsGet struct {
 	Pagination Pagination `json:"Pagination"`
 }
 
-type bunqError struct {
+// ScheduledPayments returns the scheduled payments of the response.
+func (r *ResponseScheduledPaymentsGet) ScheduledPayments() []ScheduledPayment {
+	scheduledPayments := make([]ScheduledPayment, 0, len(r.Response))
+	for _, res := range r.Response {
+		scheduledPayments = append(scheduledPayments, res.ScheduledPayment)
+	}
+
+	return scheduledPayments
+}
+
+// ResponseNotificationFilterURL The URL callbacks of a user or monetary account.
+type ResponseNotificationFilterURL struct {
+	Response []struct {
+		NotificationFilterURL NotificationFilterURL `json:"NotificationFilterUrl"`
+	} `json:"Response"`
+}
+
+// NotificationFilterURLs returns the URL callbacks of the response.
+func (r *ResponseNotificationFilterURL) NotificationFilterURLs() []NotificationFilterURL {
+	notificationFilterURLs := make([]NotificationFilterURL, 0, len(r.Response))
+	for _, res := range r.Response {
+		notificationFilterURLs = append(notificationFilterURLs, res.NotificationFilterURL)
+	}
+
+	return notificationFilterURLs
+}
+
+// BunqError An error returned by bunq.
+type BunqError struct {
 	ErrorDescription           string `json:"error_description"`
 	ErrorDescriptionTranslated string `json:"error_description_translated"`
 }
 
+// ResponseRequestResponsesGet The request response response object.
 type ResponseRequestResponsesGet struct {
 	Response []struct {
 		RequestResponse RequestResponse `json:"RequestResponse"`
 	} `json:"Response"`
 	Pagination Pagination `json:"Pagination"`
 }
+
+// RequestResponses returns the request responses of the response.
+func (r *ResponseRequestResponsesGet) RequestResponses() []RequestResponse {
+	requestResponses := make([]RequestResponse, 0, len(r.Response))
+	for _, res := range r.Response {
+		requestResponses = append(requestResponses, res.RequestResponse)
+	}
+
+	return requestResponses
+}
+
+// ResponseOAuthClients The OAuth client response object.
+type ResponseOAuthClients struct {
+	Response []struct {
+		OAuthClient OAuthClient `json:"OauthClient"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// OAuthClients returns the OAuth clients of the response.
+func (r *ResponseOAuthClients) OAuthClients() []OAuthClient {
+	oauthClients := make([]OAuthClient, 0, len(r.Response))
+	for _, res := range r.Response {
+		oauthClients = append(oauthClients, res.OAuthClient)
+	}
+
+	return oauthClients
+}
+
+// ResponseOAuthCallbackURLs The OAuth callback URL response object.
+type ResponseOAuthCallbackURLs struct {
+	Response []struct {
+		OAuthCallbackURL OAuthCallbackURL `json:"OauthCallbackUrl"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// OAuthCallbackURLs returns the callback URLs of the response.
+func (r *ResponseOAuthCallbackURLs) OAuthCallbackURLs() []OAuthCallbackURL {
+	callbackURLs := make([]OAuthCallbackURL, 0, len(r.Response))
+	for _, res := range r.Response {
+		callbackURLs = append(callbackURLs, res.OAuthCallbackURL)
+	}
+
+	return callbackURLs
+}
+
+// ResponseRequestInquiriesGet The request inquiry response object.
+type ResponseRequestInquiriesGet struct {
+	Response []struct {
+		RequestInquiry RequestInquiry `json:"RequestInquiry"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// RequestInquiries returns the request inquiries of the response.
+func (r *ResponseRequestInquiriesGet) RequestInquiries() []RequestInquiry {
+	requestInquiries := make([]RequestInquiry, 0, len(r.Response))
+	for _, res := range r.Response {
+		requestInquiries = append(requestInquiries, res.RequestInquiry)
+	}
+
+	return requestInquiries
+}
+
+// ResponseBunqMeTabsGet The bunq.me tab response object.
+type ResponseBunqMeTabsGet struct {
+	Response []struct {
+		BunqMeTab BunqMeTab `json:"BunqMeTab"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// BunqMeTabs returns the bunq.me tabs of the response.
+func (r *ResponseBunqMeTabsGet) BunqMeTabs() []BunqMeTab {
+	tabs := make([]BunqMeTab, 0, len(r.Response))
+	for _, res := range r.Response {
+		tabs = append(tabs, res.BunqMeTab)
+	}
+
+	return tabs
+}
+
+// ResponseBunqMeFundraiserProfilesGet The bunq.me fundraiser profile response object.
+type ResponseBunqMeFundraiserProfilesGet struct {
+	Response []struct {
+		BunqMeFundraiserProfile BunqMeFundraiserProfile `json:"BunqMeFundraiserProfile"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// BunqMeFundraiserProfiles returns the bunq.me fundraiser profiles of the response.
+func (r *ResponseBunqMeFundraiserProfilesGet) BunqMeFundraiserProfiles() []BunqMeFundraiserProfile {
+	profiles := make([]BunqMeFundraiserProfile, 0, len(r.Response))
+	for _, res := range r.Response {
+		profiles = append(profiles, res.BunqMeFundraiserProfile)
+	}
+
+	return profiles
+}
+
+// ResponseEventsGet The event response object.
+type ResponseEventsGet struct {
+	Response []struct {
+		Event Event `json:"Event"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// Events returns the events of the response.
+func (r *ResponseEventsGet) Events() []Event {
+	events := make([]Event, 0, len(r.Response))
+	for _, res := range r.Response {
+		events = append(events, res.Event)
+	}
+
+	return events
+}
+
+// ResponseInvoicesGet The invoice response object.
+type ResponseInvoicesGet struct {
+	Response []struct {
+		Invoice Invoice `json:"Invoice"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// Invoices returns the invoices of the response.
+func (r *ResponseInvoicesGet) Invoices() []Invoice {
+	invoices := make([]Invoice, 0, len(r.Response))
+	for _, res := range r.Response {
+		invoices = append(invoices, res.Invoice)
+	}
+
+	return invoices
+}
+
+// ResponseBillingContractsGet The billing contract response object.
+type ResponseBillingContractsGet struct {
+	Response []struct {
+		BillingContractSubscription BillingContract `json:"BillingContractSubscription"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// BillingContracts returns the billing contracts of the response.
+func (r *ResponseBillingContractsGet) BillingContracts() []BillingContract {
+	contracts := make([]BillingContract, 0, len(r.Response))
+	for _, res := range r.Response {
+		contracts = append(contracts, res.BillingContractSubscription)
+	}
+
+	return contracts
+}
+
+// ResponseShareInvitesGet The share invite response object.
+type ResponseShareInvitesGet struct {
+	Response []struct {
+		ShareInvite ShareInvite `json:"ShareInviteMonetaryAccountInquiry"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// ShareInvites returns the share invites of the response.
+func (r *ResponseShareInvitesGet) ShareInvites() []ShareInvite {
+	invites := make([]ShareInvite, 0, len(r.Response))
+	for _, res := range r.Response {
+		invites = append(invites, res.ShareInvite)
+	}
+
+	return invites
+}
+
+// ResponseShareInviteResponsesGet The share invite response response object.
+type ResponseShareInviteResponsesGet struct {
+	Response []struct {
+		ShareInviteResponse ShareInviteResponse `json:"ShareInviteMonetaryAccountResponse"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// ShareInviteResponses returns the received share invites of the response.
+func (r *ResponseShareInviteResponsesGet) ShareInviteResponses() []ShareInviteResponse {
+	invites := make([]ShareInviteResponse, 0, len(r.Response))
+	for _, res := range r.Response {
+		invites = append(invites, res.ShareInviteResponse)
+	}
+
+	return invites
+}
+
+// ResponseSddWhitelistsGet The SDD whitelist response object.
+type ResponseSddWhitelistsGet struct {
+	Response []struct {
+		SddWhitelist SddWhitelist `json:"WhitelistSdd"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// SddWhitelists returns the whitelisted creditors of the response.
+func (r *ResponseSddWhitelistsGet) SddWhitelists() []SddWhitelist {
+	whitelists := make([]SddWhitelist, 0, len(r.Response))
+	for _, res := range r.Response {
+		whitelists = append(whitelists, res.SddWhitelist)
+	}
+
+	return whitelists
+}
+
+// ResponseCurrencyConversionQuotesGet The currency conversion quote response object.
+type ResponseCurrencyConversionQuotesGet struct {
+	Response []struct {
+		CurrencyConversionQuote CurrencyConversionQuote `json:"CurrencyConversionQuote"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// CurrencyConversionQuotes returns the currency conversion quotes of the response.
+func (r *ResponseCurrencyConversionQuotesGet) CurrencyConversionQuotes() []CurrencyConversionQuote {
+	quotes := make([]CurrencyConversionQuote, 0, len(r.Response))
+	for _, res := range r.Response {
+		quotes = append(quotes, res.CurrencyConversionQuote)
+	}
+
+	return quotes
+}
+
+// ResponseTransferwiseQuotesGet The Wise quote response object.
+type ResponseTransferwiseQuotesGet struct {
+	Response []struct {
+		TransferwiseQuote TransferwiseQuote `json:"TransferwiseQuote"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// TransferwiseQuotes returns the Wise quotes of the response.
+func (r *ResponseTransferwiseQuotesGet) TransferwiseQuotes() []TransferwiseQuote {
+	quotes := make([]TransferwiseQuote, 0, len(r.Response))
+	for _, res := range r.Response {
+		quotes = append(quotes, res.TransferwiseQuote)
+	}
+
+	return quotes
+}
+
+// ResponseTransferwiseTransfersGet The Wise transfer response object.
+type ResponseTransferwiseTransfersGet struct {
+	Response []struct {
+		TransferwiseTransfer TransferwiseTransfer `json:"TransferwiseTransfer"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// TransferwiseTransfers returns the Wise transfers of the response.
+func (r *ResponseTransferwiseTransfersGet) TransferwiseTransfers() []TransferwiseTransfer {
+	transfers := make([]TransferwiseTransfer, 0, len(r.Response))
+	for _, res := range r.Response {
+		transfers = append(transfers, res.TransferwiseTransfer)
+	}
+
+	return transfers
+}
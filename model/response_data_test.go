@@ -0,0 +1,41 @@
+package model
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadResponse(t *testing.T, name string, v interface{}) {
+	raw, err := os.ReadFile("../testdata/bunq/" + name + ".json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, v))
+}
+
+func TestResponseAccessors(t *testing.T) {
+	t.Parallel()
+
+	var payments ResponsePaymentGet
+	loadResponse(t, "payment_get_response", &payments)
+	require.NotEmpty(t, payments.Payments())
+	assert.Equal(t, payments.Response[0].Payment, payments.Payments()[0])
+	assert.Len(t, payments.Payments(), len(payments.Response))
+
+	var banks ResponseMonetaryAccountBankGet
+	loadResponse(t, "monetary_account_bank_listing_response", &banks)
+	require.NotEmpty(t, banks.MonetaryAccountBanks())
+	assert.Equal(t, "NL85BUNQ9900100611", banks.MonetaryAccountBanks()[0].GetIBAN())
+
+	var id ResponseBunqID
+	loadResponse(t, "generic_id_response", &id)
+	assert.Equal(t, 6292, id.ID())
+	assert.Zero(t, (&ResponseBunqID{}).ID())
+
+	var person ResponseUserPerson
+	loadResponse(t, "user_person_get_response", &person)
+	assert.NotZero(t, person.UserPerson().ID)
+	assert.Empty(t, (&ResponseUserPerson{}).UserPerson().DisplayName)
+}
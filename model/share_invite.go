@@ -0,0 +1,141 @@
+package model
+
+// The access types of a share invite, as returned by ShareDetail.AccessType.
+const (
+	ShareAccessFull      = "FULL"
+	ShareAccessDraftOnly = "DRAFT_ONLY"
+	ShareAccessShowOnly  = "SHOW_ONLY"
+)
+
+// The statuses of a share invite.
+const (
+	ShareInviteStatusPending   = "PENDING"
+	ShareInviteStatusAccepted  = "ACCEPTED"
+	ShareInviteStatusRejected  = "REJECTED"
+	ShareInviteStatusRevoked   = "REVOKED"
+	ShareInviteStatusCancelled = "CANCELLED"
+)
+
+// The frequencies of the budget of a share invite with full access.
+const (
+	ShareBudgetFrequencyOnce    = "ONCE"
+	ShareBudgetFrequencyDaily   = "DAILY"
+	ShareBudgetFrequencyWeekly  = "WEEKLY"
+	ShareBudgetFrequencyMonthly = "MONTHLY"
+	ShareBudgetFrequencyYearly  = "YEARLY"
+)
+
+// ShareInvite A share invite the user sent for one of its monetary accounts, to give someone else access to it via bunq Connect.
+type ShareInvite struct {
+	Common
+	Alias                  LabelMonetaryAccount `json:"alias"`
+	UserAliasCreated       LabelUser            `json:"user_alias_created"`
+	UserAliasRevoked       LabelUser            `json:"user_alias_revoked"`
+	CounterUserAlias       LabelUser            `json:"counter_user_alias"`
+	MonetaryAccountID      int                  `json:"monetary_account_id"`
+	DraftShareInviteBankID int                  `json:"draft_share_invite_bank_id"`
+	ShareDetail            ShareDetail          `json:"share_detail"`
+	Status                 string               `json:"status"`
+	ShareType              string               `json:"share_type"`
+	StartDate              Time                 `json:"start_date"`
+	EndDate                Time                 `json:"end_date"`
+}
+
+// ShareInviteResponse A share invite the user received, to get access to a monetary account of someone else.
+type ShareInviteResponse struct {
+	Common
+	CounterAlias           LabelMonetaryAccount `json:"counter_alias"`
+	UserAliasCancelled     LabelUser            `json:"user_alias_cancelled"`
+	MonetaryAccountID      int                  `json:"monetary_account_id"`
+	DraftShareInviteBankID int                  `json:"draft_share_invite_bank_id"`
+	ShareDetail            ShareDetail          `json:"share_detail"`
+	Status                 string               `json:"status"`
+	ShareType              string               `json:"share_type"`
+	StartDate              Time                 `json:"start_date"`
+	EndDate                Time                 `json:"end_date"`
+	Description            string               `json:"description"`
+}
+
+// ShareDetail The access a share invite gives, exactly one of its fields is set.
+// Use FullAccess, DraftOnlyAccess or ShowOnlyAccess to create one.
+type ShareDetail struct {
+	Payment      *ShareDetailPayment      `json:"payment,omitempty"`
+	DraftPayment *ShareDetailDraftPayment `json:"draft_payment,omitempty"`
+	ReadOnly     *ShareDetailReadOnly     `json:"read_only,omitempty"`
+}
+
+// ShareDetailPayment Full access to an account: making payments, optionally within a budget, and seeing its balance and events.
+type ShareDetailPayment struct {
+	MakePayments      bool         `json:"make_payments"`
+	MakeDraftPayments bool         `json:"make_draft_payments"`
+	ViewBalance       bool         `json:"view_balance"`
+	ViewOldEvents     bool         `json:"view_old_events"`
+	ViewNewEvents     bool         `json:"view_new_events"`
+	Budget            *ShareBudget `json:"budget,omitempty"`
+}
+
+// ShareDetailDraftPayment Access to an account to make draft payments, which the owner has to accept.
+type ShareDetailDraftPayment struct {
+	MakeDraftPayments bool `json:"make_draft_payments"`
+	ViewBalance       bool `json:"view_balance"`
+	ViewOldEvents     bool `json:"view_old_events"`
+	ViewNewEvents     bool `json:"view_new_events"`
+}
+
+// ShareDetailReadOnly Access to an account to see its balance and events only.
+type ShareDetailReadOnly struct {
+	ViewBalance   bool `json:"view_balance"`
+	ViewOldEvents bool `json:"view_old_events"`
+	ViewNewEvents bool `json:"view_new_events"`
+}
+
+// ShareBudget The amount that can be spent from a shared account per period, one of the ShareBudgetFrequency constants.
+type ShareBudget struct {
+	Amount    Amount `json:"amount"`
+	Frequency string `json:"frequency"`
+}
+
+// FullAccess returns the share detail to give full access to an account, within the budget if it is not nil.
+func FullAccess(budget *ShareBudget) ShareDetail {
+	return ShareDetail{Payment: &ShareDetailPayment{
+		MakePayments:      true,
+		MakeDraftPayments: true,
+		ViewBalance:       true,
+		ViewOldEvents:     true,
+		ViewNewEvents:     true,
+		Budget:            budget,
+	}}
+}
+
+// DraftOnlyAccess returns the share detail to give access to an account to make draft payments.
+func DraftOnlyAccess() ShareDetail {
+	return ShareDetail{DraftPayment: &ShareDetailDraftPayment{
+		MakeDraftPayments: true,
+		ViewBalance:       true,
+		ViewOldEvents:     true,
+		ViewNewEvents:     true,
+	}}
+}
+
+// ShowOnlyAccess returns the share detail to give access to an account to see its balance and events.
+func ShowOnlyAccess() ShareDetail {
+	return ShareDetail{ReadOnly: &ShareDetailReadOnly{
+		ViewBalance:   true,
+		ViewOldEvents: true,
+		ViewNewEvents: true,
+	}}
+}
+
+// AccessType returns the access the share detail gives, one of the ShareAccess constants, or "" if none is set.
+func (d ShareDetail) AccessType() string {
+	switch {
+	case d.Payment != nil:
+		return ShareAccessFull
+	case d.DraftPayment != nil:
+		return ShareAccessDraftOnly
+	case d.ReadOnly != nil:
+		return ShareAccessShowOnly
+	}
+
+	return ""
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShareInviteCreateValidate(t *testing.T) {
+	t.Parallel()
+
+	to, err := EmailPointer("books@vandijk.example")
+	require.NoError(t, err)
+
+	start := NewTime(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	end := NewTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	for name, tc := range map[string]struct {
+		create ShareInviteCreate
+		valid  bool
+	}{
+		"show only":                {create: ShareInviteCreate{CounterUserAlias: to, ShareDetail: ShowOnlyAccess()}, valid: true},
+		"without access":           {create: ShareInviteCreate{CounterUserAlias: to}},
+		"two access types":         {create: ShareInviteCreate{CounterUserAlias: to, ShareDetail: ShareDetail{ReadOnly: ShowOnlyAccess().ReadOnly, DraftPayment: DraftOnlyAccess().DraftPayment}}},
+		"without counterparty":     {create: ShareInviteCreate{ShareDetail: DraftOnlyAccess()}},
+		"budget without frequency": {create: ShareInviteCreate{CounterUserAlias: to, ShareDetail: FullAccess(&ShareBudget{Amount: MustParseAmount("10.00 EUR")})}},
+		"ends before start":        {create: ShareInviteCreate{CounterUserAlias: to, ShareDetail: ShowOnlyAccess(), StartDate: &start, EndDate: &end}},
+	} {
+		err := tc.create.Validate()
+		if tc.valid {
+			assert.NoError(t, err, name)
+		} else {
+			assert.Error(t, err, name)
+		}
+	}
+}
+
+func TestShareInviteCreateJSON(t *testing.T) {
+	t.Parallel()
+
+	to, err := EmailPointer("books@vandijk.example")
+	require.NoError(t, err)
+
+	raw, err := json.Marshal(ShareInviteCreate{CounterUserAlias: to, ShareDetail: DraftOnlyAccess(), Status: ShareInviteStatusPending})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"counter_user_alias": {"type": "EMAIL", "value": "books@vandijk.example"},
+		"share_detail": {"draft_payment": {"make_draft_payments": true, "view_balance": true, "view_old_events": true, "view_new_events": true}},
+		"status": "PENDING"
+	}`, string(raw))
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TimeLayout is the layout of the timestamps used by bunq. All timestamps are in UTC.
+const TimeLayout = "2006-01-02 15:04:05.000000"
+
+// Time is a timestamp as sent and expected by bunq.
+// The zero Time is used for timestamps that are null or empty.
+type Time struct {
+	time.Time
+}
+
+// NewTime returns t as a Time in UTC.
+func NewTime(t time.Time) Time {
+	return Time{t.UTC()}
+}
+
+// ParseTime parses a timestamp in bunq's format. An empty string results in the zero Time.
+func ParseTime(s string) (Time, error) {
+	if s == "" {
+		return Time{}, nil
+	}
+
+	// Parsing accepts any number of fractional seconds, not only the six bunq uses.
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", s, time.UTC)
+	if err != nil {
+		return Time{}, errors.Wrapf(err, "bunq: invalid timestamp %q", s)
+	}
+
+	return Time{t}, nil
+}
+
+// String formats the time in bunq's format, or returns an empty string for the zero Time.
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(TimeLayout)
+}
+
+// MarshalJSON encodes the time in bunq's format, or as null for the zero Time.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes a timestamp in bunq's format, which may also be null or empty.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrap(err, "bunq: timestamp is not a string")
+	}
+
+	parsed, err := ParseTime(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+
+	return nil
+}
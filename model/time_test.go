@@ -0,0 +1,47 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeJSON(t *testing.T) {
+	t.Parallel()
+
+	var p Payment
+	require.NoError(t, json.Unmarshal([]byte(`{"created": "2024-03-04 12:15:03.468410", "updated": "2024-03-04 12:15:03", "bunqto_expiry": null, "bunqto_time_responded": ""}`), &p))
+
+	assert.Equal(t, time.Date(2024, 3, 4, 12, 15, 3, 468410000, time.UTC), p.Created.Time)
+	assert.Equal(t, time.Date(2024, 3, 4, 12, 15, 3, 0, time.UTC), p.Updated.Time)
+	assert.True(t, p.BunqtoExpiry.IsZero())
+	assert.True(t, p.BunqtoTimeResponded.IsZero())
+	assert.True(t, p.Updated.Before(p.Created.Time))
+
+	raw, err := json.Marshal(struct {
+		Created Time `json:"created"`
+		Updated Time `json:"updated"`
+	}{p.Created, p.BunqtoExpiry})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"created": "2024-03-04 12:15:03.468410", "updated": null}`, string(raw))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"created": "04-03-2024"}`), &p))
+	assert.Error(t, json.Unmarshal([]byte(`{"created": 1709554503}`), &p))
+}
+
+func TestNewTime(t *testing.T) {
+	t.Parallel()
+
+	amsterdam := time.FixedZone("CET", 60*60)
+	tm := NewTime(time.Date(2024, 3, 4, 13, 15, 3, 0, amsterdam))
+
+	assert.Equal(t, "2024-03-04 12:15:03.000000", tm.String())
+	assert.Equal(t, "", Time{}.String())
+
+	parsed, err := ParseTime(tm.String())
+	require.NoError(t, err)
+	assert.True(t, parsed.Equal(tm.Time))
+}
@@ -0,0 +1,124 @@
+package model
+
+// The types of a User, as used as key by bunq.
+const (
+	UserTypePerson  = "UserPerson"
+	UserTypeCompany = "UserCompany"
+	UserTypeAPIKey  = "UserApiKey"
+)
+
+// User is a user of bunq, either a UserPerson, a UserCompany or a UserAPIKey.
+type User interface {
+	// UserID returns the id of the user, which is used in the endpoints of the user.
+	UserID() int
+	// UserType returns UserTypePerson, UserTypeCompany or UserTypeAPIKey.
+	UserType() string
+	// UserDisplayName returns the name of the user as shown by bunq.
+	// For an API key user, it is the name of the user that granted the key.
+	UserDisplayName() string
+}
+
+// UserID returns the id of the person.
+func (u UserPerson) UserID() int { return u.ID }
+
+// UserType returns UserTypePerson.
+func (u UserPerson) UserType() string { return UserTypePerson }
+
+// UserDisplayName returns the display name of the person.
+func (u UserPerson) UserDisplayName() string { return u.DisplayName }
+
+// UserID returns the id of the company.
+func (u UserCompany) UserID() int { return u.ID }
+
+// UserType returns UserTypeCompany.
+func (u UserCompany) UserType() string { return UserTypeCompany }
+
+// UserDisplayName returns the display name of the company.
+func (u UserCompany) UserDisplayName() string { return u.DisplayName }
+
+// UserID returns the id of the API key user.
+func (u UserAPIKey) UserID() int { return u.ID }
+
+// UserType returns UserTypeAPIKey.
+func (u UserAPIKey) UserType() string { return UserTypeAPIKey }
+
+// UserDisplayName returns the display name of the user that granted the API key.
+func (u UserAPIKey) UserDisplayName() string {
+	if granted := u.GrantedBy(); granted != nil {
+		return granted.UserDisplayName()
+	}
+
+	return ""
+}
+
+// GrantedBy returns the user that granted the API key, or nil if it is unknown.
+func (u UserAPIKey) GrantedBy() User {
+	return u.GrantedByUser.User()
+}
+
+// RequestedBy returns the user that requested the API key, or nil if it is unknown.
+func (u UserAPIKey) RequestedBy() User {
+	return u.RequestedByUser.User()
+}
+
+// User returns the person or company the reference holds, or nil if it holds none.
+func (r UserReference) User() User {
+	switch {
+	case r.UserPerson.ID != 0:
+		return r.UserPerson
+	case r.UserCompany.ID != 0:
+		return r.UserCompany
+	}
+
+	return nil
+}
+
+// AnyUser holds one of the variants of a user, as returned by bunq under the key of its type.
+type AnyUser struct {
+	UserPerson  *UserPerson  `json:"UserPerson,omitempty"`
+	UserCompany *UserCompany `json:"UserCompany,omitempty"`
+	UserAPIKey  *UserAPIKey  `json:"UserApiKey,omitempty"`
+}
+
+// User returns the variant the value holds, or nil if it holds none.
+func (a AnyUser) User() User {
+	switch {
+	case a.UserPerson != nil:
+		return *a.UserPerson
+	case a.UserCompany != nil:
+		return *a.UserCompany
+	case a.UserAPIKey != nil:
+		return *a.UserAPIKey
+	}
+
+	return nil
+}
+
+// User returns the user the session belongs to, or nil if it is unknown.
+func (s SessionServer) User() User {
+	switch {
+	case s.UserPerson.ID != 0:
+		return s.UserPerson
+	case s.UserCompany.ID != 0:
+		return s.UserCompany
+	case s.UserAPIKey.ID != 0:
+		return s.UserAPIKey
+	}
+
+	return nil
+}
+
+// SessionTimeout returns the session timeout in seconds of the user,
+// for API key users the one of the user that granted the key, or 0 if it is unknown.
+func SessionTimeout(u User) int64 {
+	switch u := u.(type) {
+	case UserPerson:
+		return u.SessionTimeout
+	case UserCompany:
+		return u.SessionTimeout
+	case UserAPIKey:
+		return SessionTimeout(u.GrantedBy())
+	}
+
+	return 0
+}
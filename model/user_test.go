@@ -0,0 +1,66 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseUser(t *testing.T) {
+	t.Parallel()
+
+	var person ResponseUser
+	loadResponse(t, "user_person_get_response", &person)
+	require.IsType(t, UserPerson{}, person.User())
+	assert.Equal(t, UserTypePerson, person.User().UserType())
+	assert.Equal(t, "Wickham", person.User().UserDisplayName())
+
+	limit := person.User().(UserPerson).DailyLimitWithoutConfirmationLogin
+	assert.Equal(t, "25.00 EUR", limit.String())
+	assert.True(t, limit.Decimal.Equal(decimal.RequireFromString("25")), limit.Decimal.String())
+
+	var company ResponseUser
+	loadResponse(t, "user_company_get_response", &company)
+	require.IsType(t, UserCompany{}, company.User())
+	assert.Equal(t, 6084, company.User().UserID())
+	assert.Equal(t, "Barrett Bikes B.V.", company.User().(UserCompany).Name)
+
+	assert.Nil(t, (&ResponseUser{}).User())
+}
+
+func TestUserAPIKey(t *testing.T) {
+	t.Parallel()
+
+	var res ResponseUser
+	loadResponse(t, "user_api_key_get_response", &res)
+
+	key, ok := res.User().(UserAPIKey)
+	require.True(t, ok)
+	assert.Equal(t, 7100, key.UserID())
+	assert.Equal(t, UserTypeAPIKey, key.UserType())
+	assert.Equal(t, "Barrett Bikes", key.UserDisplayName())
+
+	granted := key.GrantedBy()
+	require.IsType(t, UserCompany{}, granted)
+	assert.Equal(t, 6084, granted.UserID())
+	assert.Equal(t, "Rita Requester", key.RequestedBy().UserDisplayName())
+	assert.EqualValues(t, 1800, SessionTimeout(key))
+
+	assert.Nil(t, UserAPIKey{}.GrantedBy())
+	assert.Empty(t, UserAPIKey{}.UserDisplayName())
+}
+
+func TestSessionServerUser(t *testing.T) {
+	t.Parallel()
+
+	var s SessionServer
+	require.NoError(t, json.Unmarshal([]byte(`{"UserApiKey": {"id": 7100, "granted_by_user": {"UserPerson": {"id": 6084, "session_timeout": 600}}}}`), &s))
+	assert.Equal(t, UserTypeAPIKey, s.User().UserType())
+	assert.EqualValues(t, 600, SessionTimeout(s.User()))
+
+	assert.Nil(t, SessionServer{}.User())
+	assert.Zero(t, SessionTimeout(nil))
+}
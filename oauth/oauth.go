@@ -0,0 +1,206 @@
+// Package oauth implements bunq's OAuth authorization code flow, to access the accounts of other bunq users.
+//
+// Redirect the user to the URL returned by AuthCodeURL, and exchange the code bunq passes to the
+// redirect URL for an access token with Exchange. The access token is used like an API key,
+// e.g. with Token.CreateContext.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+// Endpoint holds the URLs of bunq's OAuth server.
+type Endpoint struct {
+	AuthorizeURL string
+	TokenURL     string
+}
+
+var (
+	// Production is the endpoint of bunq's production environment.
+	Production = Endpoint{
+		AuthorizeURL: "https://oauth.bunq.com/auth",
+		TokenURL:     "https://api.oauth.bunq.com/v1/token",
+	}
+	// Sandbox is the endpoint of bunq's sandbox environment.
+	Sandbox = Endpoint{
+		AuthorizeURL: "https://oauth.sandbox.bunq.com/auth",
+		TokenURL:     "https://api-oauth.sandbox.bunq.com/v1/token",
+	}
+)
+
+var (
+	ErrInvalidState = errors.New("oauth: invalid state")
+	ErrMissingCode  = errors.New("oauth: missing authorization code")
+)
+
+// maxResponseSize limits the size of the token responses that are read.
+const maxResponseSize = 1 << 20
+
+// Config is the OAuth client registered at bunq.
+type Config struct {
+	// ClientID and ClientSecret of the OAuth client, as shown in the bunq app or returned by bunq.OAuthService.
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the URL bunq redirects the user to. It must be registered as callback URL of the client.
+	RedirectURL string
+	// Endpoint of the OAuth server, Production or Sandbox.
+	Endpoint Endpoint
+	// HTTPClient is used for the token requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// NewConfig creates the Config for an OAuth client as returned by bunq.OAuthService.
+// The redirect URL must be one of the callback URLs of the client.
+func NewConfig(client model.OAuthClient, redirectURL string, endpoint Endpoint) *Config {
+	return &Config{
+		ClientID:     client.ClientID,
+		ClientSecret: client.Secret,
+		RedirectURL:  redirectURL,
+		Endpoint:     endpoint,
+	}
+}
+
+// Token is the access token bunq granted to the client.
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	State       string `json:"state,omitempty"`
+}
+
+// NewState returns a random state, that is passed to AuthCodeURL and checked with VerifyState
+// when the user is redirected back, to protect against cross-site request forgery.
+func NewState() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "oauth: could not create state")
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// VerifyState checks that the state bunq passed to the redirect URL is the expected one.
+func VerifyState(expected, got string) error {
+	if expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(got)) != 1 {
+		return ErrInvalidState
+	}
+
+	return nil
+}
+
+// AuthCodeURL returns the URL to redirect the user to, to grant the client access to their accounts.
+func (c *Config) AuthCodeURL(state string) string {
+	v := url.Values{
+		"response_type": {"code"},
+		"client_id":     {c.ClientID},
+		"redirect_uri":  {c.RedirectURL},
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	sep := "?"
+	if strings.Contains(c.Endpoint.AuthorizeURL, "?") {
+		sep = "&"
+	}
+
+	return c.Endpoint.AuthorizeURL + sep + v.Encode()
+}
+
+// Exchange exchanges the authorization code for an access token.
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	if code == "" {
+		return nil, ErrMissingCode
+	}
+
+	tokenURL, err := url.Parse(c.Endpoint.TokenURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth: invalid token url")
+	}
+
+	// bunq expects the parameters in the query, not in the body.
+	q := tokenURL.Query()
+	q.Set("grant_type", "authorization_code")
+	q.Set("code", code)
+	q.Set("redirect_uri", c.RedirectURL)
+	q.Set("client_id", c.ClientID)
+	q.Set("client_secret", c.ClientSecret)
+	tokenURL.RawQuery = q.Encode()
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth: could not create token request")
+	}
+	r.Header.Set("Accept", "application/json")
+
+	res, err := c.httpClient().Do(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth: token request failed")
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "oauth: could not read token response")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, tokenError(res.StatusCode, body)
+	}
+
+	var token Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, errors.Wrap(err, "oauth: could not parse token response")
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("oauth: token response does not contain an access token")
+	}
+
+	return &token, nil
+}
+
+// CreateContext creates an API context for the access token, like bunq.CreateContext does for an API key.
+func (t *Token) CreateContext(ctx context.Context, baseURL, deviceDescription string, permittedIps []string, contextFile string) (*bunq.Client, error) {
+	return bunq.CreateContext(ctx, baseURL, t.AccessToken, deviceDescription, permittedIps, contextFile)
+}
+
+func (c *Config) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+
+	return http.DefaultClient
+}
+
+// tokenError creates the error for a failed token request, using the description of an
+// OAuth or bunq error response if there is one.
+func tokenError(status int, body []byte) error {
+	var oauthErr struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if json.Unmarshal(body, &oauthErr) == nil && oauthErr.Error != "" {
+		if oauthErr.ErrorDescription != "" {
+			return errors.Errorf("oauth: token request failed with status %d: %s: %s", status, oauthErr.Error, oauthErr.ErrorDescription)
+		}
+		return errors.Errorf("oauth: token request failed with status %d: %s", status, oauthErr.Error)
+	}
+
+	var bunqErr model.ResponseError
+	if json.Unmarshal(body, &bunqErr) == nil && len(bunqErr.Error) > 0 {
+		return errors.Errorf("oauth: token request failed with status %d: %s", status, bunqErr.Error[0].ErrorDescription)
+	}
+
+	return errors.Errorf("oauth: token request failed with status %d", status)
+}
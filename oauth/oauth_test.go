@@ -0,0 +1,125 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeTokenServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/token", r.URL.Path)
+
+		q := r.URL.Query()
+		assert.Equal(t, "authorization_code", q.Get("grant_type"))
+		assert.Equal(t, "https://example.com/callback", q.Get("redirect_uri"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case q.Get("client_id") != "client" || q.Get("client_secret") != "secret":
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client", "error_description": "Unknown client."})
+		case q.Get("code") == "used":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"Error": [{"error_description": "Authorization code is already used."}]}`))
+		case q.Get("code") == "empty":
+			_, _ = w.Write([]byte(`{"token_type": "bearer"}`))
+		default:
+			_ = json.NewEncoder(w).Encode(Token{AccessToken: "token-" + q.Get("code"), TokenType: "bearer"})
+		}
+	}))
+}
+
+func newTestConfig(tokenURL string) *Config {
+	return &Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		Endpoint:     Endpoint{AuthorizeURL: Sandbox.AuthorizeURL, TokenURL: tokenURL},
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	c := newTestConfig("")
+	u, err := url.Parse(c.AuthCodeURL("xyz"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "oauth.sandbox.bunq.com", u.Host)
+	assert.Equal(t, "/auth", u.Path)
+	assert.Equal(t, url.Values{
+		"response_type": {"code"},
+		"client_id":     {"client"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"state":         {"xyz"},
+	}, u.Query())
+
+	c.Endpoint.AuthorizeURL = "https://oauth.bunq.com/auth?lang=nl"
+	assert.Contains(t, c.AuthCodeURL(""), "/auth?lang=nl&client_id=client")
+	assert.NotContains(t, c.AuthCodeURL(""), "state=")
+}
+
+func TestState(t *testing.T) {
+	t.Parallel()
+
+	s1, err := NewState()
+	require.NoError(t, err)
+	s2, err := NewState()
+	require.NoError(t, err)
+
+	assert.Len(t, s1, 64)
+	assert.NotEqual(t, s1, s2)
+
+	assert.NoError(t, VerifyState(s1, s1))
+	assert.ErrorIs(t, VerifyState(s1, s2), ErrInvalidState)
+	assert.ErrorIs(t, VerifyState("", ""), ErrInvalidState)
+}
+
+func TestExchange(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeTokenServer(t)
+	defer server.Close()
+
+	c := newTestConfig(server.URL + "/v1/token")
+	c.HTTPClient = server.Client()
+
+	token, err := c.Exchange(context.Background(), "abc")
+	require.NoError(t, err)
+	assert.Equal(t, &Token{AccessToken: "token-abc", TokenType: "bearer"}, token)
+
+	_, err = c.Exchange(context.Background(), "")
+	assert.ErrorIs(t, err, ErrMissingCode)
+
+	_, err = c.Exchange(context.Background(), "used")
+	assert.EqualError(t, err, "oauth: token request failed with status 400: Authorization code is already used.")
+
+	_, err = c.Exchange(context.Background(), "empty")
+	assert.EqualError(t, err, "oauth: token response does not contain an access token")
+
+	c.ClientSecret = "wrong"
+	_, err = c.Exchange(context.Background(), "abc")
+	assert.EqualError(t, err, "oauth: token request failed with status 401: invalid_client: Unknown client.")
+}
+
+func TestNewConfig(t *testing.T) {
+	t.Parallel()
+
+	c := NewConfig(model.OAuthClient{ClientID: "client", Secret: "secret"}, "https://example.com/callback", Production)
+
+	assert.Equal(t, &Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://example.com/callback",
+		Endpoint:     Production,
+	}, c)
+}
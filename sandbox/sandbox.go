@@ -0,0 +1,256 @@
+// Package sandbox provisions users with money in bunq's sandbox environment, for integration tests.
+//
+// A test suite can create a fresh funded user per run:
+//
+//	user, err := sandbox.New().NewFundedUser(ctx, model.MustParseAmount("1000.00 EUR"))
+package sandbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// SugarDaddyEmail is the email address of the sandbox user that accepts all requests for money.
+	SugarDaddyEmail = "sugardaddy@bunq.com"
+
+	endpointSandboxUserPerson  = "sandbox-user-person"
+	endpointSandboxUserCompany = "sandbox-user-company"
+
+	deviceDescription = "go-bunq sandbox"
+
+	// maxResponseSize limits the size of the responses that are read.
+	maxResponseSize = 1 << 20
+)
+
+// maxRequestAmount is the largest amount the sugar daddy accepts per request.
+var maxRequestAmount = decimal.NewFromInt(500)
+
+// RequestInquiryCreator sends requests for money, like the RequestInquiryService of a bunq.Client.
+type RequestInquiryCreator interface {
+	CreateRequestInquiry(monetaryAccountID int, create model.RequestInquiryCreate) (*model.ResponseBunqID, error)
+}
+
+// Sandbox creates users in bunq's sandbox environment.
+type Sandbox struct {
+	// BaseURL of the sandbox API, bunq.BaseURLSandbox by default.
+	BaseURL string
+	// HTTPClient is used for all requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// User is a sandbox user with its client and the monetary account that was funded.
+type User struct {
+	APIKey            string
+	Client            *bunq.Client
+	MonetaryAccountID int
+}
+
+// New returns a Sandbox for bunq's sandbox environment.
+func New() *Sandbox {
+	return &Sandbox{BaseURL: bunq.BaseURLSandbox}
+}
+
+// CreateUserPerson creates a sandbox person and returns its API key.
+func (s *Sandbox) CreateUserPerson(ctx context.Context) (string, error) {
+	return s.createUser(ctx, endpointSandboxUserPerson)
+}
+
+// CreateUserCompany creates a sandbox company and returns its API key.
+func (s *Sandbox) CreateUserCompany(ctx context.Context) (string, error) {
+	return s.createUser(ctx, endpointSandboxUserCompany)
+}
+
+// NewClient creates an API context for the API key and returns its initialised client.
+// The context is saved to contextFile, unless it is empty.
+func (s *Sandbox) NewClient(ctx context.Context, apiKey, contextFile string) (*bunq.Client, error) {
+	key, err := bunq.CreateNewKeyPair()
+	if err != nil {
+		return nil, errors.Wrap(err, "sandbox: could not create key pair")
+	}
+
+	c := bunq.NewClient(ctx, s.BaseURL, key, apiKey, deviceDescription, bunq.WildcardIP)
+	if s.HTTPClient != nil {
+		c.Client = s.HTTPClient
+	}
+
+	if err := c.Init(); err != nil {
+		_ = c.Close()
+		return nil, errors.Wrap(err, "sandbox: could not initialise client")
+	}
+
+	if contextFile != "" {
+		if err := bunq.SaveContext(c, contextFile); err != nil {
+			_ = c.Close()
+			return nil, errors.Wrap(err, "sandbox: could not save context")
+		}
+	}
+
+	return c, nil
+}
+
+// NewFundedUser creates a sandbox person and requests amount from the sugar daddy
+// to its first active bank account.
+func (s *Sandbox) NewFundedUser(ctx context.Context, amount model.Amount) (*User, error) {
+	apiKey, err := s.CreateUserPerson(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.NewClient(ctx, apiKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.AccountService.GetAllMonetaryAccountBank()
+	if err != nil {
+		_ = c.Close()
+		return nil, errors.Wrap(err, "sandbox: could not get monetary accounts")
+	}
+
+	accountID := 0
+	for _, acc := range res.MonetaryAccountBanks() {
+		if acc.Status == "ACTIVE" {
+			accountID = acc.ID
+			break
+		}
+	}
+	if accountID == 0 {
+		_ = c.Close()
+		return nil, errors.New("sandbox: user has no active monetary account")
+	}
+
+	if err := RequestMoney(c.RequestInquiryService, accountID, amount); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+
+	return &User{APIKey: apiKey, Client: c, MonetaryAccountID: accountID}, nil
+}
+
+// RequestMoney requests amount, which must be in EUR, from the sugar daddy to the monetary account.
+// Amounts over 500 EUR are split into several requests, as the sugar daddy accepts at most 500 EUR at once.
+// Amounts with more than two decimals are rejected.
+func RequestMoney(requests RequestInquiryCreator, monetaryAccountID int, amount model.Amount) error {
+	if amount.Currency != "EUR" {
+		return errors.Errorf("sandbox: can only request EUR, not %s", amount.Currency)
+	}
+
+	// Amounts that were created without Decimal only have a Value.
+	d := amount.Decimal
+	if d.IsZero() && amount.Value != "" {
+		var err error
+		if d, err = decimal.NewFromString(amount.Value); err != nil {
+			return errors.Wrapf(err, "sandbox: invalid amount %q", amount.Value)
+		}
+	}
+	if !d.IsPositive() {
+		return errors.Errorf("sandbox: invalid amount %s", amount)
+	}
+
+	amount, err := model.NewAmount(d, amount.Currency)
+	if err != nil {
+		return errors.Wrap(err, "sandbox: could not request money")
+	}
+
+	sugarDaddy, err := model.EmailPointer(SugarDaddyEmail)
+	if err != nil {
+		return err
+	}
+
+	for _, part := range splitAmount(amount) {
+		_, err := requests.CreateRequestInquiry(monetaryAccountID, model.RequestInquiryCreate{
+			AmountInquired:    part,
+			CounterpartyAlias: sugarDaddy,
+			Description:       "Sandbox money",
+		})
+		if err != nil {
+			return errors.Wrapf(err, "sandbox: could not request %s", part)
+		}
+	}
+
+	return nil
+}
+
+// splitAmount splits the amount, which must have Decimal set, into parts of at most maxRequestAmount.
+func splitAmount(amount model.Amount) []model.Amount {
+	var parts []model.Amount
+
+	rest := amount.Decimal
+	for rest.GreaterThan(maxRequestAmount) {
+		parts = append(parts, model.MustNewAmount(maxRequestAmount, amount.Currency))
+		rest = rest.Sub(maxRequestAmount)
+	}
+
+	return append(parts, model.MustNewAmount(rest, amount.Currency))
+}
+
+func (s *Sandbox) createUser(ctx context.Context, endpoint string) (string, error) {
+	baseURL := s.BaseURL
+	if baseURL == "" {
+		baseURL = bunq.BaseURLSandbox
+	}
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+endpoint, strings.NewReader("{}"))
+	if err != nil {
+		return "", errors.Wrapf(err, "sandbox: could not create request for %s", endpoint)
+	}
+	r.Header.Set("Cache-Control", "no-cache")
+	r.Header.Set("User-Agent", "go-bunq")
+	r.Header.Set("X-Bunq-Language", "en_US")
+	r.Header.Set("X-Bunq-Region", "nl_NL")
+	r.Header.Set("X-Bunq-Geolocation", "0 0 0 0 NL")
+	r.Header.Set("X-Bunq-Client-Request-Id", uuid.New().String())
+
+	httpClient := s.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	res, err := httpClient.Do(r)
+	if err != nil {
+		return "", errors.Wrapf(err, "sandbox: request to %s failed", endpoint)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseSize))
+	if err != nil {
+		return "", errors.Wrapf(err, "sandbox: could not read response of %s", endpoint)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var errRes model.ResponseError
+		if json.Unmarshal(body, &errRes) == nil && len(errRes.Error) > 0 {
+			return "", errors.Errorf("sandbox: request to %s failed with status %d and description %q", endpoint, res.StatusCode, errRes.Error[0].ErrorDescription)
+		}
+		return "", errors.Errorf("sandbox: request to %s failed with status %d", endpoint, res.StatusCode)
+	}
+
+	var resAPIKey struct {
+		Response []struct {
+			APIKey struct {
+				APIKey string `json:"api_key"`
+			} `json:"ApiKey"`
+		} `json:"Response"`
+	}
+	if err := json.Unmarshal(body, &resAPIKey); err != nil {
+		return "", errors.Wrapf(err, "sandbox: could not parse response of %s", endpoint)
+	}
+	if len(resAPIKey.Response) == 0 || resAPIKey.Response[0].APIKey.APIKey == "" {
+		return "", errors.Errorf("sandbox: response of %s does not contain an API key", endpoint)
+	}
+
+	return resAPIKey.Response[0].APIKey.APIKey, nil
+}
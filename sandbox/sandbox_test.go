@@ -0,0 +1,125 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/d0x7/go-bunq/bunqtest"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFakeSandbox(t *testing.T) (*Sandbox, func()) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NotEmpty(t, r.Header.Get("X-Bunq-Client-Request-Id"))
+
+		switch r.URL.Path {
+		case "/v1/sandbox-user-person":
+			_, _ = w.Write([]byte(`{"Response": [{"ApiKey": {"api_key": "sandbox_person"}}]}`))
+		case "/v1/sandbox-user-company":
+			_, _ = w.Write([]byte(`{"Response": [{"ApiKey": {"api_key": "sandbox_company"}}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"Error": [{"error_description": "Route not found."}]}`))
+		}
+	}))
+
+	return &Sandbox{BaseURL: server.URL + "/v1/", HTTPClient: server.Client()}, server.Close
+}
+
+func TestCreateUsers(t *testing.T) {
+	t.Parallel()
+
+	s, cleanup := newFakeSandbox(t)
+	defer cleanup()
+
+	key, err := s.CreateUserPerson(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "sandbox_person", key)
+
+	key, err = s.CreateUserCompany(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "sandbox_company", key)
+
+	_, err = s.createUser(context.Background(), "sandbox-user-robot")
+	assert.EqualError(t, err, `sandbox: request to sandbox-user-robot failed with status 404 and description "Route not found."`)
+}
+
+type fakeRequests struct {
+	created []model.RequestInquiryCreate
+	err     error
+}
+
+func (f *fakeRequests) CreateRequestInquiry(monetaryAccountID int, create model.RequestInquiryCreate) (*model.ResponseBunqID, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.created = append(f.created, create)
+
+	return &model.ResponseBunqID{}, nil
+}
+
+func TestRequestMoney(t *testing.T) {
+	t.Parallel()
+
+	requests := &fakeRequests{}
+	require.NoError(t, RequestMoney(requests, 9512, model.MustParseAmount("1250.50 EUR")))
+
+	var amounts []string
+	for _, r := range requests.created {
+		assert.Equal(t, SugarDaddyEmail, r.CounterpartyAlias.Value)
+		assert.Equal(t, model.PointerTypeEmail, r.CounterpartyAlias.PType)
+		amounts = append(amounts, r.AmountInquired.String())
+	}
+	assert.Equal(t, []string{"500.00 EUR", "500.00 EUR", "250.50 EUR"}, amounts)
+
+	assert.Error(t, RequestMoney(requests, 9512, model.MustParseAmount("10.00 USD")))
+	assert.Error(t, RequestMoney(requests, 9512, model.MustParseAmount("0.00 EUR")))
+
+	// Sub-cent amounts are rejected instead of being rounded.
+	requests.created = nil
+	assert.Error(t, RequestMoney(requests, 9512, model.Amount{Value: "0.004", Currency: "EUR"}))
+	assert.Error(t, RequestMoney(requests, 9512, model.Amount{Value: "500.004", Currency: "EUR"}))
+	assert.Error(t, RequestMoney(requests, 9512, model.Amount{Decimal: decimal.RequireFromString("12.345"), Currency: "EUR"}))
+	assert.Empty(t, requests.created)
+	require.NoError(t, RequestMoney(requests, 9512, model.Amount{Value: "12.5", Currency: "EUR"}))
+	assert.Equal(t, "12.50", requests.created[0].AmountInquired.Value)
+
+	err := RequestMoney(&fakeRequests{err: errors.New("rate limited")}, 9512, model.MustParseAmount("10.00 EUR"))
+	assert.EqualError(t, err, "sandbox: could not request 10.00 EUR: rate limited")
+}
+
+func TestSplitAmount(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []model.Amount{model.MustParseAmount("500.00 EUR")}, splitAmount(model.MustParseAmount("500.00 EUR")))
+	assert.Equal(t, []model.Amount{model.MustParseAmount("12.34 EUR")}, splitAmount(model.MustParseAmount("12.34 EUR")))
+	assert.Len(t, splitAmount(model.MustParseAmount("1000.01 EUR")), 3)
+}
+
+func TestNewFundedUser(t *testing.T) {
+	t.Parallel()
+
+	srv := bunqtest.NewServer()
+	t.Cleanup(srv.Close)
+
+	s := &Sandbox{BaseURL: srv.BaseURL, HTTPClient: srv.Client()}
+	user, err := s.NewFundedUser(context.Background(), model.MustParseAmount("750.00 EUR"))
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, user.Client.Close()) })
+
+	assert.True(t, user.Client.IsUserPerson())
+
+	var amounts []string
+	for _, r := range srv.RequestInquiries(user.MonetaryAccountID) {
+		assert.Equal(t, SugarDaddyEmail, r.CounterpartyAlias.DisplayName)
+		amounts = append(amounts, r.AmountInquired.String())
+	}
+	assert.Equal(t, []string{"500.00 EUR", "250.00 EUR"}, amounts)
+}
@@ -0,0 +1,143 @@
+package sync
+
+import (
+	"database/sql"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// migrations upgrade the schema by one version each.
+// The current version is stored in the user_version pragma of the database.
+var migrations = []string{
+	`
+CREATE TABLE monetary_account (
+	id          INTEGER PRIMARY KEY,
+	type        TEXT NOT NULL,
+	description TEXT NOT NULL,
+	iban        TEXT NOT NULL,
+	currency    TEXT NOT NULL,
+	balance     TEXT NOT NULL,
+	status      TEXT NOT NULL,
+	created     TEXT NOT NULL,
+	updated     TEXT NOT NULL,
+	raw         TEXT NOT NULL
+);
+
+CREATE TABLE payment (
+	id                     INTEGER PRIMARY KEY,
+	monetary_account_id    INTEGER NOT NULL REFERENCES monetary_account (id),
+	created                TEXT NOT NULL,
+	updated                TEXT NOT NULL,
+	amount                 TEXT NOT NULL,
+	currency               TEXT NOT NULL,
+	balance_after_mutation TEXT NOT NULL,
+	counterparty_iban      TEXT NOT NULL,
+	counterparty_name      TEXT NOT NULL,
+	description            TEXT NOT NULL,
+	type                   TEXT NOT NULL,
+	sub_type               TEXT NOT NULL,
+	merchant_reference     TEXT NOT NULL,
+	raw                    TEXT NOT NULL
+);
+CREATE INDEX payment_account_created ON payment (monetary_account_id, created);
+
+CREATE TABLE mastercard_action (
+	id                   INTEGER PRIMARY KEY,
+	monetary_account_id  INTEGER NOT NULL REFERENCES monetary_account (id),
+	card_id              INTEGER NOT NULL,
+	created              TEXT NOT NULL,
+	updated              TEXT NOT NULL,
+	amount_billing       TEXT NOT NULL,
+	billing_currency     TEXT NOT NULL,
+	amount_local         TEXT NOT NULL,
+	local_currency       TEXT NOT NULL,
+	description          TEXT NOT NULL,
+	counterparty_name    TEXT NOT NULL,
+	city                 TEXT NOT NULL,
+	decision             TEXT NOT NULL,
+	authorisation_status TEXT NOT NULL,
+	raw                  TEXT NOT NULL
+);
+CREATE INDEX mastercard_action_account_created ON mastercard_action (monetary_account_id, created);
+
+CREATE TABLE scheduled_payment (
+	id                  INTEGER PRIMARY KEY,
+	monetary_account_id INTEGER NOT NULL REFERENCES monetary_account (id),
+	created             TEXT NOT NULL,
+	updated             TEXT NOT NULL,
+	status              TEXT NOT NULL,
+	amount              TEXT NOT NULL,
+	currency            TEXT NOT NULL,
+	counterparty_iban   TEXT NOT NULL,
+	counterparty_name   TEXT NOT NULL,
+	description         TEXT NOT NULL,
+	time_start          TEXT NOT NULL,
+	time_end            TEXT NOT NULL,
+	recurrence_unit     TEXT NOT NULL,
+	recurrence_size     INTEGER NOT NULL,
+	raw                 TEXT NOT NULL
+);
+
+CREATE TABLE request_response (
+	id                       INTEGER PRIMARY KEY,
+	monetary_account_id      INTEGER NOT NULL REFERENCES monetary_account (id),
+	created                  TEXT NOT NULL,
+	updated                  TEXT NOT NULL,
+	status                   TEXT NOT NULL,
+	sub_type                 TEXT NOT NULL,
+	amount_inquired          TEXT NOT NULL,
+	amount_responded         TEXT NOT NULL,
+	currency                 TEXT NOT NULL,
+	counterparty_iban        TEXT NOT NULL,
+	counterparty_name        TEXT NOT NULL,
+	description              TEXT NOT NULL,
+	credit_scheme_identifier TEXT NOT NULL,
+	mandate_identifier       TEXT NOT NULL,
+	time_responded           TEXT NOT NULL,
+	raw                      TEXT NOT NULL
+);
+
+CREATE TABLE sync_state (
+	monetary_account_id INTEGER NOT NULL,
+	resource            TEXT NOT NULL,
+	newest_id           INTEGER NOT NULL DEFAULT 0,
+	oldest_id           INTEGER NOT NULL DEFAULT 0,
+	backfill_complete   INTEGER NOT NULL DEFAULT 0,
+	last_synced         TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (monetary_account_id, resource)
+);
+`,
+}
+
+// migrate brings the database schema up to date.
+func migrate(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow(`PRAGMA user_version`).Scan(&version); err != nil {
+		return errors.Wrap(err, "sync: could not read schema version")
+	}
+
+	for ; version < len(migrations); version++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return errors.Wrap(err, "sync: could not start migration")
+		}
+
+		if _, err := tx.Exec(migrations[version]); err != nil {
+			_ = tx.Rollback()
+			return errors.Wrapf(err, "sync: could not migrate schema to version %d", version+1)
+		}
+
+		// PRAGMA statements don't support placeholders.
+		if _, err := tx.Exec(`PRAGMA user_version = ` + strconv.Itoa(version+1)); err != nil {
+			_ = tx.Rollback()
+			return errors.Wrap(err, "sync: could not update schema version")
+		}
+
+		if err := tx.Commit(); err != nil {
+			return errors.Wrap(err, "sync: could not commit migration")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package sync
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// State is the sync progress of a single resource of a monetary account.
+type State struct {
+	MonetaryAccountID int
+	Resource          string
+	// NewestID is the id of the newest item stored so far.
+	NewestID int
+	// OldestID is the id of the oldest item stored so far.
+	OldestID int
+	// BackfillComplete is true once all items older than OldestID have been stored.
+	BackfillComplete bool
+	LastSynced       time.Time
+}
+
+func (st *State) update(ids []int) {
+	for _, id := range ids {
+		if id > st.NewestID {
+			st.NewestID = id
+		}
+		if st.OldestID == 0 || id < st.OldestID {
+			st.OldestID = id
+		}
+	}
+}
+
+// States returns the sync progress of all resources of all accounts.
+func (s *Syncer) States() ([]State, error) {
+	rows, err := s.db.Query(`
+		SELECT monetary_account_id, resource, newest_id, oldest_id, backfill_complete, last_synced
+		FROM sync_state
+		ORDER BY monetary_account_id, resource`)
+	if err != nil {
+		return nil, errors.Wrap(err, "sync: could not query sync state")
+	}
+	defer rows.Close()
+
+	var states []State
+	for rows.Next() {
+		st, err := scanState(rows)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, *st)
+	}
+
+	return states, errors.Wrap(rows.Err(), "sync: could not read sync state")
+}
+
+// Reset clears the sync progress of the given account, so it is synced from scratch the next time.
+// Already stored items are kept and updated.
+func (s *Syncer) Reset(monetaryAccountID int) error {
+	_, err := s.db.Exec(`DELETE FROM sync_state WHERE monetary_account_id = ?`, monetaryAccountID)
+	return errors.Wrap(err, "sync: could not reset sync state")
+}
+
+func (s *Syncer) loadState(monetaryAccountID int, resource string) (*State, error) {
+	row := s.db.QueryRow(`
+		SELECT monetary_account_id, resource, newest_id, oldest_id, backfill_complete, last_synced
+		FROM sync_state
+		WHERE monetary_account_id = ? AND resource = ?`, monetaryAccountID, resource)
+
+	st, err := scanState(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &State{MonetaryAccountID: monetaryAccountID, Resource: resource}, nil
+	}
+
+	return st, err
+}
+
+func saveState(tx *sql.Tx, st *State) error {
+	_, err := tx.Exec(`
+		INSERT INTO sync_state (monetary_account_id, resource, newest_id, oldest_id, backfill_complete, last_synced)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (monetary_account_id, resource) DO UPDATE SET
+			newest_id = excluded.newest_id,
+			oldest_id = excluded.oldest_id,
+			backfill_complete = excluded.backfill_complete,
+			last_synced = excluded.last_synced`,
+		st.MonetaryAccountID, st.Resource, st.NewestID, st.OldestID, st.BackfillComplete, st.LastSynced.Format(time.RFC3339),
+	)
+
+	return errors.Wrap(err, "sync: could not store sync state")
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanState(row scanner) (*State, error) {
+	var st State
+	var lastSynced string
+
+	err := row.Scan(&st.MonetaryAccountID, &st.Resource, &st.NewestID, &st.OldestID, &st.BackfillComplete, &lastSynced)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "sync: could not scan sync state")
+	}
+
+	if lastSynced != "" {
+		st.LastSynced, _ = time.Parse(time.RFC3339, lastSynced)
+	}
+
+	return &st, nil
+}
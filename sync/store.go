@@ -0,0 +1,111 @@
+package sync
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+var (
+	monetaryAccountColumns = []string{
+		"id", "type", "description", "iban", "currency", "balance", "status", "created", "updated", "raw",
+	}
+	paymentColumns = []string{
+		"id", "monetary_account_id", "created", "updated", "amount", "currency", "balance_after_mutation",
+		"counterparty_iban", "counterparty_name", "description", "type", "sub_type", "merchant_reference", "raw",
+	}
+	masterCardActionColumns = []string{
+		"id", "monetary_account_id", "card_id", "created", "updated", "amount_billing", "billing_currency",
+		"amount_local", "local_currency", "description", "counterparty_name", "city", "decision",
+		"authorisation_status", "raw",
+	}
+	scheduledPaymentColumns = []string{
+		"id", "monetary_account_id", "created", "updated", "status", "amount", "currency", "counterparty_iban",
+		"counterparty_name", "description", "time_start", "time_end", "recurrence_unit", "recurrence_size", "raw",
+	}
+	requestResponseColumns = []string{
+		"id", "monetary_account_id", "created", "updated", "status", "sub_type", "amount_inquired",
+		"amount_responded", "currency", "counterparty_iban", "counterparty_name", "description",
+		"credit_scheme_identifier", "mandate_identifier", "time_responded", "raw",
+	}
+)
+
+// upsert inserts a row into table, or updates all columns if a row with the same id already exists.
+// The first column must be the id.
+func upsert(tx *sql.Tx, table string, columns []string, values ...interface{}) error {
+	updates := make([]string, 0, len(columns)-1)
+	for _, c := range columns[1:] {
+		updates = append(updates, c+" = excluded."+c)
+	}
+
+	query := "INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ")" +
+		" VALUES (?" + strings.Repeat(", ?", len(columns)-1) + ")" +
+		" ON CONFLICT (id) DO UPDATE SET " + strings.Join(updates, ", ")
+
+	if _, err := tx.Exec(query, values...); err != nil {
+		return errors.Wrapf(err, "sync: could not store %s", table)
+	}
+
+	return nil
+}
+
+// rawJSON returns the JSON representation of v, which is stored alongside the extracted columns.
+func rawJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+func upsertMonetaryAccountBank(tx *sql.Tx, a model.MonetaryAccountBank) error {
+	return upsert(tx, "monetary_account", monetaryAccountColumns,
+		a.ID, "bank", a.Description, a.GetIBAN(), a.Currency, a.Balance.Value, a.Status, a.Created.String(), a.Updated.String(),
+		rawJSON(a),
+	)
+}
+
+func upsertMonetaryAccountSaving(tx *sql.Tx, a model.MonetaryAccountSaving) error {
+	return upsert(tx, "monetary_account", monetaryAccountColumns,
+		a.ID, "savings", a.Description, a.GetIBAN(), a.Currency, a.Balance.Value, a.Status, a.Created.String(), a.Updated.String(),
+		rawJSON(a),
+	)
+}
+
+func upsertPayment(tx *sql.Tx, p model.Payment) error {
+	return upsert(tx, "payment", paymentColumns,
+		p.ID, p.MonetaryAccountID, p.Created.String(), p.Updated.String(), p.Amount.Value, p.Amount.Currency,
+		p.BalanceAfterMutation.Value, p.CounterpartyAlias.IBAN, p.CounterpartyAlias.DisplayName, p.Description,
+		p.Type, p.SubType, p.MerchantReference, rawJSON(p),
+	)
+}
+
+func upsertMasterCardAction(tx *sql.Tx, monetaryAccountID int, a model.MasterCardAction) error {
+	return upsert(tx, "mastercard_action", masterCardActionColumns,
+		a.ID, monetaryAccountID, a.CardID, a.Created.String(), a.Updated.String(),
+		a.AmountBilling.Value, a.AmountBilling.Currency, a.AmountLocal.Value, a.AmountLocal.Currency,
+		a.Description, a.CounterpartyAlias.DisplayName, a.City, a.Decision, a.AuthorisationStatus,
+		rawJSON(a),
+	)
+}
+
+func upsertScheduledPayment(tx *sql.Tx, monetaryAccountID int, sp model.ScheduledPayment) error {
+	return upsert(tx, "scheduled_payment", scheduledPaymentColumns,
+		sp.ID, monetaryAccountID, sp.Created.String(), sp.Updated.String(), sp.Status, sp.Payment.Amount.Value,
+		sp.Payment.Amount.Currency, sp.Payment.CounterpartyAlias.IBAN, sp.Payment.CounterpartyAlias.DisplayName,
+		sp.Payment.Description, sp.Schedule.TimeStart.String(), sp.Schedule.TimeEnd.String(), sp.Schedule.RecurrenceUnit,
+		sp.Schedule.RecurrenceSize, rawJSON(sp),
+	)
+}
+
+func upsertRequestResponse(tx *sql.Tx, monetaryAccountID int, rr model.RequestResponse) error {
+	return upsert(tx, "request_response", requestResponseColumns,
+		rr.ID, monetaryAccountID, rr.Created.String(), rr.Updated.String(), rr.Status, rr.SubType, rr.AmountInquired.Value,
+		rr.AmountResponded.Value, rr.AmountInquired.Currency, rr.CounterpartyAlias.IBAN,
+		rr.CounterpartyAlias.DisplayName, rr.Description, rr.CreditSchemeID, rr.MandateID, rr.Responded.String(),
+		rawJSON(rr),
+	)
+}
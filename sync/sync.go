@@ -0,0 +1,405 @@
+// Package sync mirrors monetary accounts and their transactions into a local SQLite database.
+//
+// The mirror is updated incrementally using bunq's id based pagination,
+// and the progress of every account is stored in the database, so an interrupted sync resumes where it stopped.
+// Scheduled payments and request responses that are still pending are fetched again on every sync, so their status stays current.
+package sync
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/d0x7/go-bunq/bunq"
+	"github.com/d0x7/go-bunq/model"
+	"github.com/d0x7/go-bunq/pagination"
+	"github.com/pkg/errors"
+
+	// Registers the pure Go sqlite driver.
+	_ "modernc.org/sqlite"
+)
+
+// DefaultPageSize is the number of items requested per page, which is the maximum bunq allows.
+const DefaultPageSize = 200
+
+// Names of the synced resources, as stored in the sync_state table.
+const (
+	ResourcePayment          = "payment"
+	ResourceMasterCardAction = "mastercard_action"
+	ResourceScheduledPayment = "scheduled_payment"
+	ResourceRequestResponse  = "request_response"
+)
+
+// source holds the bunq API calls used for syncing, so they can be replaced in tests.
+type source struct {
+	monetaryAccountBanks   func(params ...model.QueryParam) (*model.ResponseMonetaryAccountBankGet, error)
+	monetaryAccountSavings func(params ...model.QueryParam) (*model.ResponseMonetaryAccountSavingGet, error)
+	payments               func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponsePaymentGet, error)
+	masterCardActions      func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseMasterCardActionGet, error)
+	scheduledPayments      func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseScheduledPaymentsGet, error)
+	requestResponses       func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestResponsesGet, error)
+}
+
+// Syncer mirrors the data of a bunq user into a SQLite database.
+type Syncer struct {
+	db  *sql.DB
+	src source
+
+	// PageSize is the number of items requested per page, defaults to DefaultPageSize.
+	PageSize int
+}
+
+// Open opens the SQLite database at the given path, creating it if it doesn't exist yet.
+func Open(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, errors.Wrap(err, "sync: could not open database")
+	}
+
+	return db, nil
+}
+
+// New creates a new Syncer that mirrors the data available to the client into db.
+// The database schema is created or upgraded when needed.
+func New(client *bunq.Client, db *sql.DB) (*Syncer, error) {
+	return newSyncer(db, source{
+		monetaryAccountBanks:   client.AccountService.GetAllMonetaryAccountBank,
+		monetaryAccountSavings: client.AccountService.GetAllMonetaryAccountSaving,
+		payments:               client.PaymentService.GetAllPayment,
+		masterCardActions:      client.CardService.GetAllMasterCardAction,
+		scheduledPayments:      client.ScheduledPaymentService.GetAllScheduledPayments,
+		requestResponses:       client.RequestResponseService.GetAllRequestResponses,
+	})
+}
+
+func newSyncer(db *sql.DB, src source) (*Syncer, error) {
+	if err := migrate(db); err != nil {
+		return nil, err
+	}
+
+	return &Syncer{db: db, src: src, PageSize: DefaultPageSize}, nil
+}
+
+// DB returns the database the syncer writes to.
+func (s *Syncer) DB() *sql.DB {
+	return s.db
+}
+
+// SyncAll syncs all monetary accounts, followed by all resources of each account.
+func (s *Syncer) SyncAll() error {
+	ids, err := s.SyncAccounts()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := s.SyncAccount(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SyncAccounts stores all bank and savings accounts and returns their ids.
+func (s *Syncer) SyncAccounts() ([]int, error) {
+	var ids []int
+
+	var params []model.QueryParam
+	for {
+		res, err := s.src.monetaryAccountBanks(append(params, pagination.Count(s.pageSize()))...)
+		if err != nil {
+			return nil, errors.Wrap(err, "sync: could not list bank accounts")
+		}
+
+		err = s.inTx(func(tx *sql.Tx) error {
+			for _, r := range res.Response {
+				if err := upsertMonetaryAccountBank(tx, r.MonetaryAccountBank); err != nil {
+					return err
+				}
+				ids = append(ids, r.MonetaryAccountBank.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !res.Pagination.HasPrevious() {
+			break
+		}
+		params = []model.QueryParam{res.Pagination.PreviousPage()}
+	}
+
+	params = nil
+	for {
+		res, err := s.src.monetaryAccountSavings(append(params, pagination.Count(s.pageSize()))...)
+		if err != nil {
+			return nil, errors.Wrap(err, "sync: could not list savings accounts")
+		}
+
+		err = s.inTx(func(tx *sql.Tx) error {
+			for _, r := range res.Response {
+				if err := upsertMonetaryAccountSaving(tx, r.MonetaryAccountSaving); err != nil {
+					return err
+				}
+				ids = append(ids, r.MonetaryAccountSaving.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !res.Pagination.HasPrevious() {
+			break
+		}
+		params = []model.QueryParam{res.Pagination.PreviousPage()}
+	}
+
+	return ids, nil
+}
+
+// SyncAccount syncs all resources of the given monetary account.
+func (s *Syncer) SyncAccount(monetaryAccountID int) error {
+	for _, r := range s.resources() {
+		if err := s.syncResource(monetaryAccountID, r); err != nil {
+			return errors.Wrapf(err, "sync: could not sync %s of account %d", r.name, monetaryAccountID)
+		}
+	}
+
+	return nil
+}
+
+// SyncResource syncs a single resource, e.g. ResourcePayment, of the given monetary account.
+func (s *Syncer) SyncResource(monetaryAccountID int, name string) error {
+	for _, r := range s.resources() {
+		if r.name == name {
+			return s.syncResource(monetaryAccountID, r)
+		}
+	}
+
+	return errors.Errorf("sync: unknown resource %q", name)
+}
+
+// page is a single page of a resource listing.
+type page struct {
+	ids        []int
+	store      func(tx *sql.Tx) error
+	pagination model.Pagination
+}
+
+type resource struct {
+	name  string
+	fetch func(monetaryAccountID int, params ...model.QueryParam) (*page, error)
+	// table and pending are set for resources whose status changes after they were created.
+	// Stored items with one of the pending statuses are fetched again on every sync.
+	table   string
+	pending []string
+}
+
+func (s *Syncer) resources() []resource {
+	return []resource{
+		{name: ResourcePayment, fetch: func(id int, params ...model.QueryParam) (*page, error) {
+			res, err := s.src.payments(id, params...)
+			if err != nil {
+				return nil, err
+			}
+			p := &page{pagination: res.Pagination}
+			for _, r := range res.Response {
+				p.ids = append(p.ids, r.Payment.ID)
+			}
+			p.store = func(tx *sql.Tx) error {
+				for _, r := range res.Response {
+					if err := upsertPayment(tx, r.Payment); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			return p, nil
+		}},
+		{name: ResourceMasterCardAction, fetch: func(id int, params ...model.QueryParam) (*page, error) {
+			res, err := s.src.masterCardActions(id, params...)
+			if err != nil {
+				return nil, err
+			}
+			p := &page{pagination: res.Pagination}
+			for _, r := range res.Response {
+				p.ids = append(p.ids, r.MasterCardAction.ID)
+			}
+			p.store = func(tx *sql.Tx) error {
+				for _, r := range res.Response {
+					if err := upsertMasterCardAction(tx, id, r.MasterCardAction); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			return p, nil
+		}},
+		{name: ResourceScheduledPayment, table: "scheduled_payment", pending: []string{"ACTIVE", "ON_HOLD"}, fetch: func(id int, params ...model.QueryParam) (*page, error) {
+			res, err := s.src.scheduledPayments(id, params...)
+			if err != nil {
+				return nil, err
+			}
+			p := &page{pagination: res.Pagination}
+			for _, r := range res.Response {
+				p.ids = append(p.ids, r.ScheduledPayment.ID)
+			}
+			p.store = func(tx *sql.Tx) error {
+				for _, r := range res.Response {
+					if err := upsertScheduledPayment(tx, id, r.ScheduledPayment); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			return p, nil
+		}},
+		{name: ResourceRequestResponse, table: "request_response", pending: []string{model.RequestResponseStatusPending, "REFUND_REQUESTED"}, fetch: func(id int, params ...model.QueryParam) (*page, error) {
+			res, err := s.src.requestResponses(id, params...)
+			if err != nil {
+				return nil, err
+			}
+			p := &page{pagination: res.Pagination}
+			for _, r := range res.Response {
+				p.ids = append(p.ids, r.RequestResponse.ID)
+			}
+			p.store = func(tx *sql.Tx) error {
+				for _, r := range res.Response {
+					if err := upsertRequestResponse(tx, id, r.RequestResponse); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			return p, nil
+		}},
+	}
+}
+
+// syncResource first walks back in history until the oldest item has been stored,
+// and afterwards fetches everything newer than the newest stored item.
+// For resources with pending statuses this starts at the oldest pending item instead, so its status is updated.
+// The state is committed together with every page, so an interrupted sync continues with the next page.
+func (s *Syncer) syncResource(monetaryAccountID int, r resource) error {
+	st, err := s.loadState(monetaryAccountID, r.name)
+	if err != nil {
+		return err
+	}
+
+	for !st.BackfillComplete {
+		params := []model.QueryParam{pagination.Count(s.pageSize())}
+		if st.OldestID != 0 {
+			params = append(params, pagination.OlderThan(st.OldestID))
+		}
+
+		p, err := r.fetch(monetaryAccountID, params...)
+		if err != nil {
+			return err
+		}
+
+		st.update(p.ids)
+		st.BackfillComplete = len(p.ids) == 0 || !p.pagination.HasPrevious()
+
+		if err := s.storePage(p, st); err != nil {
+			return err
+		}
+	}
+
+	if st.NewestID == 0 {
+		return nil
+	}
+
+	newerThan := st.NewestID
+	if len(r.pending) > 0 {
+		id, err := s.oldestPending(monetaryAccountID, r)
+		if err != nil {
+			return err
+		}
+		if id != 0 && id <= newerThan {
+			newerThan = id - 1
+		}
+	}
+
+	for {
+		p, err := r.fetch(monetaryAccountID, pagination.Count(s.pageSize()), pagination.NewerThan(newerThan))
+		if err != nil {
+			return err
+		}
+
+		if len(p.ids) == 0 {
+			break
+		}
+
+		st.update(p.ids)
+		if err := s.storePage(p, st); err != nil {
+			return err
+		}
+
+		for _, id := range p.ids {
+			if id > newerThan {
+				newerThan = id
+			}
+		}
+
+		if !p.pagination.HasNext() {
+			break
+		}
+	}
+
+	return nil
+}
+
+// oldestPending returns the id of the oldest stored item of the resource that has a pending status, or 0 if there is none.
+func (s *Syncer) oldestPending(monetaryAccountID int, r resource) (int, error) {
+	args := []interface{}{monetaryAccountID}
+	for _, status := range r.pending {
+		args = append(args, status)
+	}
+
+	var id sql.NullInt64
+	err := s.db.QueryRow(
+		"SELECT MIN(id) FROM "+r.table+" WHERE monetary_account_id = ? AND status IN (?"+strings.Repeat(", ?", len(r.pending)-1)+")",
+		args...,
+	).Scan(&id)
+	if err != nil {
+		return 0, errors.Wrapf(err, "sync: could not query pending %s", r.name)
+	}
+
+	return int(id.Int64), nil
+}
+
+func (s *Syncer) storePage(p *page, st *State) error {
+	return s.inTx(func(tx *sql.Tx) error {
+		if err := p.store(tx); err != nil {
+			return err
+		}
+
+		st.LastSynced = time.Now().UTC()
+		return saveState(tx, st)
+	})
+}
+
+// inTx runs fn within a transaction, which is committed if fn succeeds and rolled back otherwise.
+func (s *Syncer) inTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "sync: could not start transaction")
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return errors.Wrap(tx.Commit(), "sync: could not commit transaction")
+}
+
+func (s *Syncer) pageSize() int {
+	if s.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	return s.PageSize
+}
@@ -0,0 +1,265 @@
+package sync
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const accountID = 9520
+
+// fakeBunq serves paginated listings the way bunq does: newest first, with older and newer page urls.
+type fakeBunq struct {
+	paymentIDs []int
+	// requestResponses maps the ids of the request responses to their status.
+	requestResponses map[int]string
+	// failOlderThan makes the payment listing fail when requesting items older than the given id.
+	failOlderThan int
+	calls         []url.Values
+}
+
+func (f *fakeBunq) paginate(ids []int, params ...model.QueryParam) ([]int, model.Pagination, error) {
+	query := url.Values{}
+	for _, p := range params {
+		if err := p(query); err != nil {
+			return nil, model.Pagination{}, err
+		}
+	}
+	f.calls = append(f.calls, query)
+
+	count, _ := strconv.Atoi(query.Get("count"))
+	sorted := append([]int(nil), ids...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+
+	var result []int
+	if newer := query.Get("newer_id"); newer != "" {
+		id, _ := strconv.Atoi(newer)
+		for i := len(sorted) - 1; i >= 0 && len(result) < count; i-- {
+			if sorted[i] > id {
+				result = append([]int{sorted[i]}, result...)
+			}
+		}
+	} else {
+		older := len(sorted) + 1<<30
+		if o := query.Get("older_id"); o != "" {
+			older, _ = strconv.Atoi(o)
+			if older == f.failOlderThan {
+				return nil, model.Pagination{}, errors.New("rate limit exceeded")
+			}
+		}
+		for _, id := range sorted {
+			if id < older && len(result) < count {
+				result = append(result, id)
+			}
+		}
+	}
+
+	var p model.Pagination
+	if len(result) > 0 {
+		newest, oldest := result[0], result[len(result)-1]
+		if oldest > sorted[len(sorted)-1] {
+			p.OlderURL = fmt.Sprintf("/v1/payment?count=%d&older_id=%d", count, oldest)
+		}
+		if newest < sorted[0] {
+			p.NewerURL = fmt.Sprintf("/v1/payment?count=%d&newer_id=%d", count, newest)
+		} else {
+			p.FutureURL = fmt.Sprintf("/v1/payment?count=%d&newer_id=%d", count, newest)
+		}
+	}
+
+	return result, p, nil
+}
+
+func (f *fakeBunq) source() source {
+	return source{
+		monetaryAccountBanks: func(params ...model.QueryParam) (*model.ResponseMonetaryAccountBankGet, error) {
+			var res model.ResponseMonetaryAccountBankGet
+			decode(fmt.Sprintf(`{"Response":[{"MonetaryAccountBank":{"id":%d,"currency":"EUR","description":"main","balance":{"value":"12.00","currency":"EUR"},"alias":[{"type":"IBAN","value":"NL85BUNQ9900100611"}]}}]}`, accountID), &res)
+			return &res, nil
+		},
+		monetaryAccountSavings: func(params ...model.QueryParam) (*model.ResponseMonetaryAccountSavingGet, error) {
+			return &model.ResponseMonetaryAccountSavingGet{}, nil
+		},
+		payments: func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponsePaymentGet, error) {
+			ids, p, err := f.paginate(f.paymentIDs, params...)
+			if err != nil {
+				return nil, err
+			}
+
+			res := model.ResponsePaymentGet{Pagination: p}
+			for _, id := range ids {
+				var item struct {
+					Payment model.Payment `json:"Payment"`
+				}
+				decode(fmt.Sprintf(`{"Payment":{"id":%d,"monetary_account_id":%d,"created":"2024-01-01 12:00:00.000000","amount":{"value":"-1.00","currency":"EUR"},"description":"payment %d"}}`, id, monetaryAccountID, id), &item)
+				res.Response = append(res.Response, item)
+			}
+			return &res, nil
+		},
+		masterCardActions: func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseMasterCardActionGet, error) {
+			var res model.ResponseMasterCardActionGet
+			decode(`{"Response":[{"MasterCardAction":{"id":324,"card_id":1,"description":"coffee","amount_billing":{"value":"2.50","currency":"EUR"}}}]}`, &res)
+			return &res, nil
+		},
+		scheduledPayments: func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseScheduledPaymentsGet, error) {
+			return &model.ResponseScheduledPaymentsGet{}, nil
+		},
+		requestResponses: func(monetaryAccountID int, params ...model.QueryParam) (*model.ResponseRequestResponsesGet, error) {
+			var all []int
+			for id := range f.requestResponses {
+				all = append(all, id)
+			}
+
+			ids, p, err := f.paginate(all, params...)
+			if err != nil {
+				return nil, err
+			}
+
+			res := model.ResponseRequestResponsesGet{Pagination: p}
+			for _, id := range ids {
+				var item struct {
+					RequestResponse model.RequestResponse `json:"RequestResponse"`
+				}
+				decode(fmt.Sprintf(`{"RequestResponse":{"id":%d,"status":%q,"sub_type":"DIRECT_DEBIT","mandate_identifier":"M%d"}}`, id, f.requestResponses[id], id), &item)
+				res.Response = append(res.Response, item)
+			}
+			return &res, nil
+		},
+	}
+}
+
+func decode(data string, v interface{}) {
+	if err := json.Unmarshal([]byte(data), v); err != nil {
+		panic(err)
+	}
+}
+
+func createSyncer(t *testing.T, f *fakeBunq) *Syncer {
+	db, err := Open(filepath.Join(t.TempDir(), "bunq.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	s, err := newSyncer(db, f.source())
+	require.NoError(t, err)
+	s.PageSize = 2
+
+	return s
+}
+
+func count(t *testing.T, s *Syncer, table string) int {
+	var n int
+	require.NoError(t, s.DB().QueryRow("SELECT COUNT(*) FROM "+table).Scan(&n))
+	return n
+}
+
+func TestSyncer_SyncAll(t *testing.T) {
+	t.Parallel()
+
+	f := &fakeBunq{paymentIDs: []int{1, 2, 3, 4, 5}, requestResponses: map[int]string{7: "PENDING"}}
+	s := createSyncer(t, f)
+
+	require.NoError(t, s.SyncAll())
+
+	assert.Equal(t, 1, count(t, s, "monetary_account"))
+	assert.Equal(t, 5, count(t, s, "payment"))
+	assert.Equal(t, 1, count(t, s, "mastercard_action"))
+	assert.Equal(t, 1, count(t, s, "request_response"))
+
+	var iban, balance string
+	require.NoError(t, s.DB().QueryRow(`SELECT iban, balance FROM monetary_account WHERE id = ?`, accountID).Scan(&iban, &balance))
+	assert.Equal(t, "NL85BUNQ9900100611", iban)
+	assert.Equal(t, "12.00", balance)
+
+	st, err := s.loadState(accountID, ResourcePayment)
+	require.NoError(t, err)
+	assert.True(t, st.BackfillComplete)
+	assert.Equal(t, 5, st.NewestID)
+	assert.Equal(t, 1, st.OldestID)
+
+	// New payments are picked up incrementally.
+	f.paymentIDs = append(f.paymentIDs, 6, 7, 8)
+	f.calls = nil
+	require.NoError(t, s.SyncResource(accountID, ResourcePayment))
+
+	assert.Equal(t, 8, count(t, s, "payment"))
+	assert.Equal(t, "5", f.calls[0].Get("newer_id"))
+	assert.Len(t, f.calls, 2)
+}
+
+func TestSyncer_Resume(t *testing.T) {
+	t.Parallel()
+
+	f := &fakeBunq{paymentIDs: []int{1, 2, 3, 4, 5}, failOlderThan: 4}
+	s := createSyncer(t, f)
+
+	_, err := s.SyncAccounts()
+	require.NoError(t, err)
+	assert.Error(t, s.SyncResource(accountID, ResourcePayment))
+	assert.Equal(t, 2, count(t, s, "payment"))
+
+	f.failOlderThan = 0
+	f.calls = nil
+	require.NoError(t, s.SyncResource(accountID, ResourcePayment))
+
+	assert.Equal(t, 5, count(t, s, "payment"))
+	assert.Equal(t, "4", f.calls[0].Get("older_id"))
+
+	states, err := s.States()
+	require.NoError(t, err)
+	if assert.Len(t, states, 1) {
+		assert.True(t, states[0].BackfillComplete)
+		assert.False(t, states[0].LastSynced.IsZero())
+	}
+}
+
+func TestSyncer_PendingStatus(t *testing.T) {
+	t.Parallel()
+
+	f := &fakeBunq{requestResponses: map[int]string{1: "ACCEPTED", 2: "PENDING", 3: "PENDING", 4: "REJECTED"}}
+	s := createSyncer(t, f)
+
+	_, err := s.SyncAccounts()
+	require.NoError(t, err)
+	require.NoError(t, s.SyncResource(accountID, ResourceRequestResponse))
+
+	status := func(id int) string {
+		var st string
+		require.NoError(t, s.DB().QueryRow(`SELECT status FROM request_response WHERE id = ?`, id).Scan(&st))
+		return st
+	}
+	assert.Equal(t, "PENDING", status(2))
+
+	// The pending request responses are fetched again, together with the new one.
+	f.requestResponses[2] = "ACCEPTED"
+	f.requestResponses[5] = "PENDING"
+	f.calls = nil
+	require.NoError(t, s.SyncResource(accountID, ResourceRequestResponse))
+
+	assert.Equal(t, "ACCEPTED", status(2))
+	assert.Equal(t, 5, count(t, s, "request_response"))
+	assert.Equal(t, "1", f.calls[0].Get("newer_id"))
+
+	st, err := s.loadState(accountID, ResourceRequestResponse)
+	require.NoError(t, err)
+	assert.Equal(t, 5, st.NewestID)
+
+	f.calls = nil
+	require.NoError(t, s.SyncResource(accountID, ResourceRequestResponse))
+	assert.Equal(t, "2", f.calls[0].Get("newer_id"))
+}
+
+func TestSyncer_UnknownResource(t *testing.T) {
+	t.Parallel()
+
+	s := createSyncer(t, &fakeBunq{})
+	assert.Error(t, s.SyncResource(accountID, "unknown"))
+}
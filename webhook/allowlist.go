@@ -0,0 +1,145 @@
+package webhook
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultCallbackRanges are the IP ranges bunq sends callbacks from.
+var DefaultCallbackRanges = []string{"185.40.108.0/22"}
+
+// ErrForbiddenSource is passed to OnError for callbacks from outside the allowed ranges.
+var ErrForbiddenSource = errors.New("webhook: callback from forbidden source")
+
+// IPAllowlist is http middleware that only lets callbacks from the allowed IP ranges through.
+type IPAllowlist struct {
+	allowed []netip.Prefix
+	proxies []netip.Prefix
+	header  string
+
+	// OnError, when set, is called with every rejected request.
+	OnError func(r *http.Request, err error)
+}
+
+// NewIPAllowlist creates an IPAllowlist for the given CIDR ranges or addresses,
+// or for DefaultCallbackRanges if none are given.
+func NewIPAllowlist(ranges ...string) (*IPAllowlist, error) {
+	if len(ranges) == 0 {
+		ranges = DefaultCallbackRanges
+	}
+
+	allowed, err := parsePrefixes(ranges)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IPAllowlist{allowed: allowed}, nil
+}
+
+// TrustProxies makes the allowlist take the source of requests coming from one of the
+// given proxies from header, e.g. X-Forwarded-For or X-Real-IP. When the header holds
+// a list of addresses, the last address that is not a trusted proxy is the source.
+func (a *IPAllowlist) TrustProxies(header string, proxies ...string) error {
+	prefixes, err := parsePrefixes(proxies)
+	if err != nil {
+		return err
+	}
+
+	a.header = header
+	a.proxies = prefixes
+
+	return nil
+}
+
+// Middleware returns next wrapped in the allowlist. Requests from other sources get a 403.
+func (a *IPAllowlist) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		source, ok := a.source(r)
+		if !ok || !contains(a.allowed, source) {
+			if a.OnError != nil {
+				a.OnError(r, errors.Wrapf(ErrForbiddenSource, "source %s", source))
+			}
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// source determines the address the request was sent from.
+func (a *IPAllowlist) source(r *http.Request) (netip.Addr, bool) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	remote, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	remote = remote.Unmap()
+
+	if a.header == "" || !contains(a.proxies, remote) {
+		return remote, true
+	}
+
+	var hops []string
+	for _, v := range r.Header.Values(a.header) {
+		hops = append(hops, strings.Split(v, ",")...)
+	}
+	if len(hops) == 0 {
+		// The proxy did not forward the source, so it can't be trusted.
+		return netip.Addr{}, false
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			return netip.Addr{}, false
+		}
+		addr = addr.Unmap()
+
+		if i == 0 || !contains(a.proxies, addr) {
+			return addr, true
+		}
+	}
+
+	return netip.Addr{}, false
+}
+
+func parsePrefixes(ranges []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(ranges))
+	for _, r := range ranges {
+		if !strings.Contains(r, "/") {
+			addr, err := netip.ParseAddr(r)
+			if err != nil {
+				return nil, errors.Wrapf(err, "webhook: invalid address %q", r)
+			}
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "webhook: invalid range %q", r)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+func contains(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, p := range prefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPAllowlist(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewIPAllowlist()
+	require.NoError(t, err)
+
+	h := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		remoteAddr string
+		status     int
+	}{
+		{"185.40.108.17:41234", http.StatusOK},
+		{"185.40.111.255:41234", http.StatusOK},
+		{"[::ffff:185.40.109.3]:41234", http.StatusOK},
+		{"185.40.112.1:41234", http.StatusForbidden},
+		{"10.0.0.1:41234", http.StatusForbidden},
+		{"garbage", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/bunq/callback", nil)
+		r.RemoteAddr = tt.remoteAddr
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equal(t, tt.status, w.Code, tt.remoteAddr)
+	}
+}
+
+func TestIPAllowlistProxies(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewIPAllowlist("185.40.108.0/22", "192.0.2.10")
+	require.NoError(t, err)
+	require.NoError(t, a.TrustProxies("X-Forwarded-For", "10.0.0.0/8"))
+
+	h := a.Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor string
+		status       int
+	}{
+		{"bunq through proxy", "10.0.0.2:80", "185.40.108.17", http.StatusOK},
+		{"bunq through two proxies", "10.0.0.2:80", "185.40.108.17, 10.1.1.1", http.StatusOK},
+		{"spoofed first hop", "10.0.0.2:80", "185.40.108.17, 203.0.113.5", http.StatusForbidden},
+		{"single address", "10.0.0.2:80", "192.0.2.10", http.StatusOK},
+		{"proxy without header", "10.0.0.2:80", "", http.StatusForbidden},
+		{"header from untrusted source", "203.0.113.5:80", "185.40.108.17", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/bunq/callback", nil)
+		r.RemoteAddr = tt.remoteAddr
+		if tt.forwardedFor != "" {
+			r.Header.Set("X-Forwarded-For", tt.forwardedFor)
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equal(t, tt.status, w.Code, tt.name)
+	}
+
+	_, err = NewIPAllowlist("185.40.108.0/33")
+	assert.Error(t, err)
+}
@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+// The keys of the object bunq sends along with a notification.
+const (
+	ObjectPayment          = "Payment"
+	ObjectMasterCardAction = "MasterCardAction"
+	ObjectRequestInquiry   = "RequestInquiry"
+	ObjectRequestResponse  = "RequestResponse"
+	ObjectDraftPayment     = "DraftPayment"
+)
+
+// Notification is the envelope of every callback bunq sends.
+type Notification struct {
+	TargetURL string                     `json:"target_url"`
+	Category  model.NotificationCategory `json:"category"`
+	EventType string                     `json:"event_type"`
+	// Object holds the object the notification is about, keyed by its type, e.g. {"Payment": {...}}.
+	Object map[string]json.RawMessage `json:"object"`
+}
+
+// Envelope returns the notification the event was delivered in.
+func (n *Notification) Envelope() *Notification {
+	return n
+}
+
+// ObjectType returns the type of the object the notification is about, e.g. "Payment".
+func (n *Notification) ObjectType() string {
+	for k := range n.Object {
+		return k
+	}
+
+	return ""
+}
+
+// objectHeader holds the fields every bunq object has.
+type objectHeader struct {
+	ID      int        `json:"id"`
+	Created model.Time `json:"created"`
+	Updated model.Time `json:"updated"`
+}
+
+func (n *Notification) header() objectHeader {
+	var h objectHeader
+	_ = json.Unmarshal(n.Object[n.ObjectType()], &h)
+
+	return h
+}
+
+// ObjectID returns the id of the object the notification is about, or 0 if it has none.
+func (n *Notification) ObjectID() int {
+	return n.header().ID
+}
+
+// ObjectTime returns the time the object the notification is about was last updated.
+// It returns the zero time if the object has no timestamps.
+func (n *Notification) ObjectTime() time.Time {
+	h := n.header()
+	if h.Updated.IsZero() {
+		return h.Created.Time
+	}
+
+	return h.Updated.Time
+}
+
+// Event is a notification decoded into its typed event.
+// It is one of *PaymentEvent, *MutationEvent, *CardActionEvent, *RequestInquiryEvent,
+// *RequestResponseEvent, *DraftPaymentEvent or *UnknownEvent.
+type Event interface {
+	Envelope() *Notification
+}
+
+// PaymentEvent is sent for the PAYMENT category, when a payment is made from or to an account.
+type PaymentEvent struct {
+	Notification
+	Payment model.Payment
+}
+
+// MutationEvent is sent for the MUTATION category, for every change of the balance of an account.
+type MutationEvent struct {
+	Notification
+	Payment model.Payment
+}
+
+// CardActionEvent is sent for the card transaction categories, when a card is used.
+type CardActionEvent struct {
+	Notification
+	MasterCardAction model.MasterCardAction
+}
+
+// RequestInquiryEvent is sent when a request for money sent by the user changes.
+type RequestInquiryEvent struct {
+	Notification
+	RequestInquiry model.RequestInquiry
+}
+
+// RequestResponseEvent is sent when the user receives a request for money, e.g. a direct debit.
+type RequestResponseEvent struct {
+	Notification
+	RequestResponse model.RequestResponse
+}
+
+// DraftPaymentEvent is sent when a draft payment is created or changes.
+type DraftPaymentEvent struct {
+	Notification
+	DraftPayment model.DraftPayment
+}
+
+// UnknownEvent is a notification about an object this package does not decode.
+// The raw object is available through Notification.Object.
+type UnknownEvent struct {
+	Notification
+}
+
+type envelope struct {
+	NotificationURL *Notification `json:"NotificationUrl"`
+}
+
+// Parse decodes the body of a bunq callback into its typed event.
+func Parse(body []byte) (Event, error) {
+	var env envelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return nil, errors.Wrap(err, "webhook: could not parse notification")
+	}
+	if env.NotificationURL == nil {
+		return nil, errors.New("webhook: body is not a notification")
+	}
+
+	n := *env.NotificationURL
+	objectType := n.ObjectType()
+	raw := n.Object[objectType]
+
+	var event Event
+	var target interface{}
+
+	switch objectType {
+	case ObjectPayment:
+		if n.Category == model.NotificationCategoryMutation {
+			e := &MutationEvent{Notification: n}
+			event, target = e, &e.Payment
+		} else {
+			e := &PaymentEvent{Notification: n}
+			event, target = e, &e.Payment
+		}
+	case ObjectMasterCardAction:
+		e := &CardActionEvent{Notification: n}
+		event, target = e, &e.MasterCardAction
+	case ObjectRequestInquiry:
+		e := &RequestInquiryEvent{Notification: n}
+		event, target = e, &e.RequestInquiry
+	case ObjectRequestResponse:
+		e := &RequestResponseEvent{Notification: n}
+		event, target = e, &e.RequestResponse
+	case ObjectDraftPayment:
+		e := &DraftPaymentEvent{Notification: n}
+		event, target = e, &e.DraftPayment
+	default:
+		return &UnknownEvent{Notification: n}, nil
+	}
+
+	if err := json.Unmarshal(raw, target); err != nil {
+		return nil, errors.Wrapf(err, "webhook: could not parse %s of notification", objectType)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrStaleEvent is returned for events about objects that were last updated too long ago.
+var ErrStaleEvent = errors.New("webhook: stale event")
+
+// Store records the notifications that have been processed.
+type Store interface {
+	// Add records the key until expiry and reports whether it was not recorded yet.
+	Add(ctx context.Context, key string, expiry time.Time) (bool, error)
+	// Remove forgets the key, so a retry of the notification is processed again.
+	Remove(ctx context.Context, key string) error
+}
+
+// MemoryStore is a Store that keeps the keys in memory.
+// It is only suitable when a single process receives the callbacks.
+type MemoryStore struct {
+	mu   sync.Mutex
+	keys map[string]time.Time
+	now  func() time.Time
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{keys: make(map[string]time.Time), now: time.Now}
+}
+
+// Add records the key until expiry and reports whether it was not recorded yet.
+func (s *MemoryStore) Add(_ context.Context, key string, expiry time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for k, exp := range s.keys {
+		if !exp.After(now) {
+			delete(s.keys, k)
+		}
+	}
+
+	if _, ok := s.keys[key]; ok {
+		return false, nil
+	}
+	s.keys[key] = expiry
+
+	return true, nil
+}
+
+// Remove forgets the key.
+func (s *MemoryStore) Remove(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, key)
+
+	return nil
+}
+
+// DeduplicationKey returns the key identifying the notification of an event,
+// made up of the event type and the type and id of its object.
+func DeduplicationKey(e Event) string {
+	n := e.Envelope()
+
+	return fmt.Sprintf("%s:%s:%d", n.EventType, n.ObjectType(), n.ObjectID())
+}
+
+// Deduplicate drops events that have already been processed within ttl, as reported by the store.
+// Duplicates are acknowledged without calling the handlers. When a handler fails, the event is
+// removed from the store again, so the retry by bunq is processed.
+func Deduplicate(store Store, ttl time.Duration) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, e Event) error {
+			if e.Envelope().ObjectID() == 0 {
+				return next(ctx, e)
+			}
+
+			key := DeduplicationKey(e)
+			added, err := store.Add(ctx, key, time.Now().Add(ttl))
+			if err != nil {
+				return errors.Wrap(err, "webhook: could not record notification")
+			}
+			if !added {
+				return nil
+			}
+
+			if err := next(ctx, e); err != nil {
+				if rmErr := store.Remove(ctx, key); rmErr != nil {
+					return errors.Wrapf(err, "webhook: could not forget notification after error: %v", rmErr)
+				}
+				return err
+			}
+
+			return nil
+		}
+	}
+}
+
+// RejectStale rejects events about objects that were last updated more than maxAge ago,
+// so a recorded callback can't be replayed later on. The handler responds to them with a 400.
+// Events about objects without timestamps are passed on.
+func RejectStale(maxAge time.Duration) Middleware {
+	return rejectStale(maxAge, time.Now)
+}
+
+func rejectStale(maxAge time.Duration, now func() time.Time) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, e Event) error {
+			t := e.Envelope().ObjectTime()
+			if !t.IsZero() && now().Sub(t) > maxAge {
+				return errors.Wrapf(ErrStaleEvent, "object last updated at %s", t.Format(time.RFC3339))
+			}
+
+			return next(ctx, e)
+		}
+	}
+}
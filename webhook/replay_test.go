@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeduplicate(t *testing.T) {
+	t.Parallel()
+
+	h, key := newTestHandler(t)
+	h.Use(Deduplicate(NewMemoryStore(), time.Hour))
+
+	calls := 0
+	fail := true
+	h.OnPayment(func(context.Context, *PaymentEvent) error {
+		calls++
+		if fail {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+
+	send := func(name string) int {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newCallback(t, key, name))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusInternalServerError, send("payment"))
+
+	fail = false
+	assert.Equal(t, http.StatusOK, send("payment"), "retry after a failure is processed")
+	assert.Equal(t, http.StatusOK, send("payment"), "duplicate is acknowledged")
+	assert.Equal(t, 2, calls)
+
+	assert.Equal(t, http.StatusOK, send("mutation"))
+	assert.Equal(t, http.StatusOK, send("unknown"))
+}
+
+func TestDeduplicationKey(t *testing.T) {
+	t.Parallel()
+
+	event, err := Parse(loadNotification(t, "card_action"))
+	assert.NoError(t, err)
+	assert.Equal(t, "CARD_TRANSACTION_SUCCESSFUL:MasterCardAction:302984932", DeduplicationKey(event))
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+
+	added, err := s.Add(context.Background(), "a", now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, added)
+
+	added, _ = s.Add(context.Background(), "a", now.Add(time.Minute))
+	assert.False(t, added)
+
+	now = now.Add(2 * time.Minute)
+	added, _ = s.Add(context.Background(), "a", now.Add(time.Minute))
+	assert.True(t, added, "expired keys are forgotten")
+}
+
+func TestRejectStale(t *testing.T) {
+	t.Parallel()
+
+	h, key := newTestHandler(t)
+
+	// The payment fixture was updated at 2024-03-04 12:15:03 UTC.
+	now := time.Date(2024, 3, 4, 12, 20, 0, 0, time.UTC)
+	h.Use(rejectStale(10*time.Minute, func() time.Time { return now }))
+
+	calls := 0
+	h.OnPayment(func(context.Context, *PaymentEvent) error {
+		calls++
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newCallback(t, key, "payment"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	now = now.Add(time.Hour)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newCallback(t, key, "payment"))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Equal(t, 1, calls)
+}
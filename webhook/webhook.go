@@ -0,0 +1,236 @@
+// Package webhook receives the callbacks bunq sends to the URLs registered as notification filters.
+package webhook
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"net/http"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	// HeaderSignature is the header holding the signature of the callback body.
+	HeaderSignature = "X-Bunq-Server-Signature"
+
+	// maxBodySize limits the size of the callbacks that are read.
+	maxBodySize = 1 << 20
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook: missing server signature")
+	ErrInvalidSignature = errors.New("webhook: invalid server signature")
+)
+
+// HandlerFunc handles a single event. Returning an error makes the handler
+// respond with a 500, after which bunq retries the callback.
+type HandlerFunc func(ctx context.Context, e Event) error
+
+// Middleware wraps the dispatch of an event to the handlers, see Handler.Use.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Handler is an http.Handler receiving bunq callbacks.
+// It verifies the signature of every callback, decodes it into its typed event and
+// passes it to the handlers registered for that event.
+//
+// Handlers have to be registered before the Handler serves its first request.
+type Handler struct {
+	serverPublicKey *rsa.PublicKey
+	handlers        []HandlerFunc
+	middleware      []Middleware
+
+	// OnError, when set, is called with every error that caused a callback to be rejected.
+	OnError func(r *http.Request, err error)
+}
+
+// NewHandler creates a Handler verifying callbacks with the given bunq server public key.
+func NewHandler(serverPublicKey *rsa.PublicKey) *Handler {
+	return &Handler{serverPublicKey: serverPublicKey}
+}
+
+// NewHandlerFromContext creates a Handler verifying callbacks with the server public key
+// that bunq returned during installation of the given context.
+func NewHandlerFromContext(clientCtx *model.ClientContext) (*Handler, error) {
+	if clientCtx.InstallationContext == nil {
+		return nil, errors.New("webhook: client context has no installation")
+	}
+
+	block, _ := pem.Decode([]byte(clientCtx.InstallationContext.ServerPublicKey.ServerPublicKey))
+	if block == nil {
+		return nil, errors.New("webhook: client context has no server public key")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "webhook: could not parse server public key")
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("webhook: server public key is not an RSA key")
+	}
+
+	return NewHandler(rsaKey), nil
+}
+
+// Handle registers a handler for every event, including events of unknown objects.
+func (h *Handler) Handle(fn HandlerFunc) {
+	h.handlers = append(h.handlers, fn)
+}
+
+// Use adds middleware that wraps the dispatch of every event to the handlers.
+// Middleware only sees events whose signature has been verified.
+// The first middleware added is the outermost one.
+func (h *Handler) Use(mw ...Middleware) {
+	h.middleware = append(h.middleware, mw...)
+}
+
+// OnPayment registers a handler for payment events.
+func (h *Handler) OnPayment(fn func(ctx context.Context, e *PaymentEvent) error) {
+	h.Handle(func(ctx context.Context, e Event) error {
+		if pe, ok := e.(*PaymentEvent); ok {
+			return fn(ctx, pe)
+		}
+		return nil
+	})
+}
+
+// OnMutation registers a handler for mutation events.
+func (h *Handler) OnMutation(fn func(ctx context.Context, e *MutationEvent) error) {
+	h.Handle(func(ctx context.Context, e Event) error {
+		if me, ok := e.(*MutationEvent); ok {
+			return fn(ctx, me)
+		}
+		return nil
+	})
+}
+
+// OnCardAction registers a handler for card transaction events.
+func (h *Handler) OnCardAction(fn func(ctx context.Context, e *CardActionEvent) error) {
+	h.Handle(func(ctx context.Context, e Event) error {
+		if ce, ok := e.(*CardActionEvent); ok {
+			return fn(ctx, ce)
+		}
+		return nil
+	})
+}
+
+// OnRequestInquiry registers a handler for events about requests sent by the user.
+func (h *Handler) OnRequestInquiry(fn func(ctx context.Context, e *RequestInquiryEvent) error) {
+	h.Handle(func(ctx context.Context, e Event) error {
+		if re, ok := e.(*RequestInquiryEvent); ok {
+			return fn(ctx, re)
+		}
+		return nil
+	})
+}
+
+// OnRequestResponse registers a handler for events about requests received by the user.
+func (h *Handler) OnRequestResponse(fn func(ctx context.Context, e *RequestResponseEvent) error) {
+	h.Handle(func(ctx context.Context, e Event) error {
+		if re, ok := e.(*RequestResponseEvent); ok {
+			return fn(ctx, re)
+		}
+		return nil
+	})
+}
+
+// OnDraftPayment registers a handler for draft payment events.
+func (h *Handler) OnDraftPayment(fn func(ctx context.Context, e *DraftPaymentEvent) error) {
+	h.Handle(func(ctx context.Context, e Event) error {
+		if de, ok := e.(*DraftPaymentEvent); ok {
+			return fn(ctx, de)
+		}
+		return nil
+	})
+}
+
+// ServeHTTP verifies, decodes and dispatches a single callback.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, errors.Wrap(err, "webhook: could not read body"))
+		return
+	}
+
+	if err := Verify(h.serverPublicKey, body, r.Header.Get(HeaderSignature)); err != nil {
+		h.fail(w, r, http.StatusUnauthorized, err)
+		return
+	}
+
+	event, err := Parse(body)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.dispatcher()(r.Context(), event); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Cause(err) == ErrStaleEvent {
+			// Rejected events must not be retried by bunq.
+			status = http.StatusBadRequest
+		}
+		h.fail(w, r, status, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatcher returns the function passing an event to all handlers, wrapped in the middleware.
+func (h *Handler) dispatcher() HandlerFunc {
+	dispatch := func(ctx context.Context, e Event) error {
+		for _, fn := range h.handlers {
+			if err := fn(ctx, e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i := len(h.middleware) - 1; i >= 0; i-- {
+		dispatch = h.middleware[i](dispatch)
+	}
+
+	return dispatch
+}
+
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, status int, err error) {
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+
+	http.Error(w, http.StatusText(status), status)
+}
+
+// Verify checks that the base64 encoded signature is a signature of body by bunq.
+func Verify(serverPublicKey *rsa.PublicKey, body []byte, signature string) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	h := sha256.Sum256(body)
+	if err := rsa.VerifyPKCS1v15(serverPublicKey, crypto.SHA256, h[:], sig); err != nil {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/d0x7/go-bunq/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadPrivateKey(t *testing.T) *rsa.PrivateKey {
+	raw, err := os.ReadFile("../testdata/bunq/private.key")
+	require.NoError(t, err)
+
+	block, _ := pem.Decode(raw)
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	require.NoError(t, err)
+
+	return key
+}
+
+func loadNotification(t *testing.T, name string) []byte {
+	body, err := os.ReadFile("../testdata/webhook/" + name + ".json")
+	require.NoError(t, err)
+
+	return body
+}
+
+func sign(t *testing.T, key *rsa.PrivateKey, body []byte) string {
+	h := sha256.Sum256(body)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, h[:])
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func newCallback(t *testing.T, key *rsa.PrivateKey, name string) *http.Request {
+	body := loadNotification(t, name)
+
+	r := httptest.NewRequest(http.MethodPost, "/bunq/callback", bytes.NewReader(body))
+	r.Header.Set(HeaderSignature, sign(t, key, body))
+
+	return r
+}
+
+func newTestHandler(t *testing.T) (*Handler, *rsa.PrivateKey) {
+	key := loadPrivateKey(t)
+
+	return NewHandler(&key.PublicKey), key
+}
+
+func TestHandlerDispatch(t *testing.T) {
+	t.Parallel()
+
+	h, key := newTestHandler(t)
+
+	var payments []*PaymentEvent
+	var mutations []*MutationEvent
+	var cardActions []*CardActionEvent
+	var all []Event
+
+	h.OnPayment(func(_ context.Context, e *PaymentEvent) error {
+		payments = append(payments, e)
+		return nil
+	})
+	h.OnMutation(func(_ context.Context, e *MutationEvent) error {
+		mutations = append(mutations, e)
+		return nil
+	})
+	h.OnCardAction(func(_ context.Context, e *CardActionEvent) error {
+		cardActions = append(cardActions, e)
+		return nil
+	})
+	h.Handle(func(_ context.Context, e Event) error {
+		all = append(all, e)
+		return nil
+	})
+
+	for _, name := range []string{"payment", "mutation", "card_action", "unknown"} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, newCallback(t, key, name))
+		assert.Equal(t, http.StatusOK, w.Code, name)
+	}
+
+	require.Len(t, payments, 1)
+	assert.Equal(t, 4024672, payments[0].Payment.ID)
+	assert.Equal(t, "-12.50", payments[0].Payment.Amount.Value)
+	assert.Equal(t, "PAYMENT_CREATED", payments[0].EventType)
+	assert.Equal(t, model.NotificationCategoryPayment, payments[0].Category)
+
+	require.Len(t, mutations, 1)
+	assert.Equal(t, "Salary", mutations[0].Payment.Description)
+
+	require.Len(t, cardActions, 1)
+	assert.Equal(t, "Coffee Corner", cardActions[0].MasterCardAction.Description)
+
+	require.Len(t, all, 4)
+	unknown, ok := all[3].(*UnknownEvent)
+	require.True(t, ok)
+	assert.Equal(t, "ShareInviteMonetaryAccountInquiry", unknown.ObjectType())
+}
+
+func TestHandlerRejectsInvalidCallbacks(t *testing.T) {
+	t.Parallel()
+
+	h, key := newTestHandler(t)
+	h.Handle(func(context.Context, Event) error {
+		t.Error("handler called for an invalid callback")
+		return nil
+	})
+
+	var errs []error
+	h.OnError = func(_ *http.Request, err error) {
+		errs = append(errs, err)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/bunq/callback", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	r := newCallback(t, key, "payment")
+	r.Header.Del(HeaderSignature)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, newCallback(t, otherKey, "payment"))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	body := []byte(`{"Response": []}`)
+	r = httptest.NewRequest(http.MethodPost, "/bunq/callback", bytes.NewReader(body))
+	r.Header.Set(HeaderSignature, sign(t, key, body))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Equal(t, []error{ErrMissingSignature, ErrInvalidSignature}, errs[:2])
+}
+
+func TestHandlerError(t *testing.T) {
+	t.Parallel()
+
+	h, key := newTestHandler(t)
+	h.OnPayment(func(context.Context, *PaymentEvent) error {
+		return errors.New("database unavailable")
+	})
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newCallback(t, key, "payment"))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestNewHandlerFromContext(t *testing.T) {
+	t.Parallel()
+
+	raw, err := os.ReadFile("../testdata/bunq/installation_response.json")
+	require.NoError(t, err)
+
+	var res model.ResponseInstallation
+	require.NoError(t, json.Unmarshal(raw, &res))
+
+	var installation model.Installation
+	for _, r := range res.Response {
+		if r.ServerPublicKey.ServerPublicKey != "" {
+			installation.ServerPublicKey = r.ServerPublicKey
+		}
+	}
+
+	h, err := NewHandlerFromContext(&model.ClientContext{InstallationContext: &installation})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, newCallback(t, loadPrivateKey(t), "payment"))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	_, err = NewHandlerFromContext(&model.ClientContext{})
+	assert.Error(t, err)
+}